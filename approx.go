@@ -0,0 +1,213 @@
+package must
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+	"strings"
+)
+
+/*
+Tolerance describes how far apart two numbers may be while still being considered approximately equal.
+
+Numbers are approximately equal if they are within any of the non-zero tolerances. The zero value only accepts exactly equal numbers.
+*/
+type Tolerance struct {
+	Absolute float64 // Maximum absolute difference between the numbers
+	Relative float64 // Maximum difference relative to the larger magnitude of the two numbers
+	ULPs     uint64  // Maximum distance in units in the last place, the number of representable floating point values between the numbers
+}
+
+/*
+BeApproxEqual compares the numbers expected and got, triggering an error on t if they are not within tolerance of one another.
+Floating point, complex and integer values are supported, as well as slices and arrays of them, such as matrices of type [][]float64, which are compared element-wise.
+For complex numbers, ULP distance is measured separately for the real and imaginary parts.
+
+Should any numbers differ, the error will include the actual difference and each tolerance that was exceeded.
+
+The return value will be true if all numbers are approximately equal.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeApproxEqual(t TestingT, expected, got interface{}, tolerance Tolerance, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeApproxEqual(expected, got, tolerance, a...)
+}
+
+/*
+BeApproxEqual compares the numbers expected and got, triggering an error on the Tester's T if they are not within tolerance of one another.
+
+This corresponds to the function BeApproxEqual
+*/
+func (tester Tester) BeApproxEqual(expected, got interface{}, tolerance Tolerance, a ...interface{}) bool {
+	tester.T.Helper()
+	var report []string
+	err := compareApprox("", reflect.ValueOf(expected), reflect.ValueOf(got), tolerance, &report)
+	if err != nil {
+		tester.formattedError("could not compare values - %v", a, err)
+		return false
+	}
+	if len(report) == 0 {
+		return true
+	}
+	tester.formattedError("values not approximately equal\n%s", a, strings.Join(report, "\n"))
+	return false
+}
+
+// compareApprox appends a description of each element of expected and got that is not within tolerance to report.
+func compareApprox(path string, expected, got reflect.Value, tolerance Tolerance, report *[]string) error {
+	expected, got = unwrapInterface(expected), unwrapInterface(got)
+	if isList(expected) || isList(got) {
+		if !isList(expected) || !isList(got) {
+			return fmt.Errorf("%scannot compare %v with %v", pathPrefix(path), typeName(expected), typeName(got))
+		}
+		if expected.Len() != got.Len() {
+			return fmt.Errorf("%sexpected length %d, got length %d", pathPrefix(path), expected.Len(), got.Len())
+		}
+		for i := 0; i < expected.Len(); i++ {
+			if err := compareApprox(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), got.Index(i), tolerance, report); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	e, eSingle, err := toComplex(expected)
+	if err != nil {
+		return fmt.Errorf("%s%v", pathPrefix(path), err)
+	}
+	g, gSingle, err := toComplex(got)
+	if err != nil {
+		return fmt.Errorf("%s%v", pathPrefix(path), err)
+	}
+	if violations := approxViolations(e, g, eSingle || gSingle, tolerance); len(violations) > 0 {
+		*report = append(*report, fmt.Sprintf("%sexpected %v, got %v (%s)", pathPrefix(path), expected, got, strings.Join(violations, "; ")))
+	}
+	return nil
+}
+
+/*
+approxViolations describes how e and g exceed each non-zero tolerance, or returns nil if they are within any of them.
+Where single is true, ULP distance is measured in single precision.
+*/
+func approxViolations(e, g complex128, single bool, tolerance Tolerance) []string {
+	if e == g || (cmplx.IsNaN(e) && cmplx.IsNaN(g)) {
+		return nil
+	}
+	delta := cmplx.Abs(e - g)
+	if tolerance == (Tolerance{}) {
+		return []string{fmt.Sprintf("delta %g, no tolerance set", delta)}
+	}
+
+	var violations []string
+	if tolerance.Absolute != 0 {
+		if delta <= tolerance.Absolute {
+			return nil
+		}
+		violations = append(violations, fmt.Sprintf("delta %g exceeds absolute tolerance %g", delta, tolerance.Absolute))
+	}
+	if tolerance.Relative != 0 {
+		relative := delta / math.Max(cmplx.Abs(e), cmplx.Abs(g))
+		if relative <= tolerance.Relative {
+			return nil
+		}
+		violations = append(violations, fmt.Sprintf("relative delta %g exceeds relative tolerance %g", relative, tolerance.Relative))
+	}
+	if tolerance.ULPs != 0 {
+		distance := ulps(real(e), real(g), single)
+		if imagDistance := ulps(imag(e), imag(g), single); imagDistance > distance {
+			distance = imagDistance
+		}
+		if distance <= tolerance.ULPs {
+			return nil
+		}
+		violations = append(violations, fmt.Sprintf("distance %d ULPs exceeds tolerance %d ULPs", distance, tolerance.ULPs))
+	}
+	return violations
+}
+
+// ulps returns the number of representable floating point values between a and b, in single or double precision.
+func ulps(a, b float64, single bool) uint64 {
+	if a == b {
+		return 0
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.MaxUint64
+	}
+	var x, y uint64
+	if single {
+		x, y = uint64(orderedBits32(float32(a))), uint64(orderedBits32(float32(b)))
+	} else {
+		x, y = orderedBits64(a), orderedBits64(b)
+	}
+	if x > y {
+		return x - y
+	}
+	return y - x
+}
+
+// orderedBits64 maps f onto an unsigned integer such that adjacent floating point values map to adjacent integers.
+func orderedBits64(f float64) uint64 {
+	bits := math.Float64bits(f)
+	if bits>>63 == 1 {
+		return ^bits
+	}
+	return bits | 1<<63
+}
+
+// orderedBits32 maps f onto an unsigned integer such that adjacent floating point values map to adjacent integers.
+func orderedBits32(f float32) uint32 {
+	bits := math.Float32bits(f)
+	if bits>>31 == 1 {
+		return ^bits
+	}
+	return bits | 1<<31
+}
+
+// toComplex converts a numeric value to complex128, reporting whether it was of single precision.
+func toComplex(v reflect.Value) (complex128, bool, error) {
+	switch v.Kind() {
+	case reflect.Float32:
+		return complex(v.Float(), 0), true, nil
+	case reflect.Float64:
+		return complex(v.Float(), 0), false, nil
+	case reflect.Complex64:
+		return v.Complex(), true, nil
+	case reflect.Complex128:
+		return v.Complex(), false, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return complex(float64(v.Int()), 0), false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return complex(float64(v.Uint()), 0), false, nil
+	case reflect.Invalid:
+		return 0, false, fmt.Errorf("cannot compare nil")
+	}
+	return 0, false, fmt.Errorf("cannot compare non-numeric type: %v", v.Type())
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+func unwrapInterface(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+func typeName(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	return v.Type().String()
+}
+
+func pathPrefix(path string) string {
+	if path == "" {
+		return ""
+	}
+	return path + ": "
+}
@@ -0,0 +1,145 @@
+package must
+
+import (
+	"math"
+	"testing"
+)
+
+// Variables rather than constants, so sums are computed in floating point.
+var tenth, fifth = 0.1, 0.2
+
+func TestBeApproxEqual(t *testing.T) {
+	var tests = []struct {
+		name       string
+		expected   interface{}
+		got        interface{}
+		tolerance  Tolerance
+		shouldPass bool
+		format     string
+		report     string
+	}{
+		{
+			name:       "exactly equal",
+			expected:   1.5,
+			got:        1.5,
+			shouldPass: true,
+		},
+		{
+			name:     "no tolerance",
+			expected: 0.3,
+			got:      tenth + fifth,
+			format:   "values not approximately equal\n%s",
+			report:   "expected 0.3, got 0.30000000000000004 (delta 5.551115123125783e-17, no tolerance set)",
+		},
+		{
+			name:       "within absolute",
+			expected:   0.3,
+			got:        tenth + fifth,
+			tolerance:  Tolerance{Absolute: 1e-9},
+			shouldPass: true,
+		},
+		{
+			name:      "outside absolute",
+			expected:  1.0,
+			got:       1.5,
+			tolerance: Tolerance{Absolute: 0.1},
+			format:    "values not approximately equal\n%s",
+			report:    "expected 1, got 1.5 (delta 0.5 exceeds absolute tolerance 0.1)",
+		},
+		{
+			name:       "within relative",
+			expected:   1000.0,
+			got:        1001.0,
+			tolerance:  Tolerance{Relative: 0.01},
+			shouldPass: true,
+		},
+		{
+			name:       "within ULPs",
+			expected:   1.0,
+			got:        math.Nextafter(math.Nextafter(1, 2), 2),
+			tolerance:  Tolerance{ULPs: 2},
+			shouldPass: true,
+		},
+		{
+			name:       "within single precision ULPs",
+			expected:   float32(1),
+			got:        math.Nextafter32(1, 2),
+			tolerance:  Tolerance{ULPs: 1},
+			shouldPass: true,
+		},
+		{
+			name:       "ULPs across zero",
+			expected:   math.Copysign(math.SmallestNonzeroFloat64, -1),
+			got:        math.SmallestNonzeroFloat64,
+			tolerance:  Tolerance{ULPs: 3},
+			shouldPass: true,
+		},
+		{
+			name:      "outside multiple tolerances",
+			expected:  1.0,
+			got:       2.0,
+			tolerance: Tolerance{Absolute: 0.5, Relative: 0.25, ULPs: 4},
+			format:    "values not approximately equal\n%s",
+			report:    "expected 1, got 2 (delta 1 exceeds absolute tolerance 0.5; relative delta 0.5 exceeds relative tolerance 0.25; distance 4503599627370496 ULPs exceeds tolerance 4 ULPs)",
+		},
+		{
+			name:       "complex within absolute",
+			expected:   complex(1, 1),
+			got:        complex(1, 1.05),
+			tolerance:  Tolerance{Absolute: 0.1},
+			shouldPass: true,
+		},
+		{
+			name:       "mixed int and float",
+			expected:   1,
+			got:        1.0000001,
+			tolerance:  Tolerance{Absolute: 1e-6},
+			shouldPass: true,
+		},
+		{
+			name:      "matrix",
+			expected:  [][]float64{{1, 2}, {3, 4}},
+			got:       [][]float64{{1, 2.01}, {3, 4.5}},
+			tolerance: Tolerance{Absolute: 0.1},
+			format:    "values not approximately equal\n%s",
+			report:    "[1][1]: expected 4, got 4.5 (delta 0.5 exceeds absolute tolerance 0.1)",
+		},
+		{
+			name:       "slice of interfaces",
+			expected:   []interface{}{1.0, float32(2)},
+			got:        []float64{1.0, 2.0},
+			shouldPass: true,
+		},
+		{
+			name:     "different lengths",
+			expected: []float64{1, 2},
+			got:      []float64{1},
+			format:   "could not compare values - %v",
+		},
+		{
+			name:     "slice and number",
+			expected: []float64{1},
+			got:      nil,
+			format:   "could not compare values - %v",
+		},
+		{
+			name:     "string",
+			expected: "1",
+			got:      1.0,
+			format:   "could not compare values - %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeApproxEqual(test.expected, test.got, test.tolerance)
+			checkResults(t, test.shouldPass, result, test.format, m)
+			if test.report != "" && (len(m.args) != 1 || m.args[0] != test.report) {
+				t.Errorf("Incorrect report. Expected:\n%s\ngot:\n%v", test.report, m.args)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package must
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+BeTrue checks that got is true, triggering an error on t if it is not.
+
+Where the source of the calling test is available, the error will include the expression passed as got, such as:
+
+	expected true: len(users) > 3 && users[0].Admin
+
+The return value will be true if got is true.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeTrue(t TestingT, got bool, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeTrue(got, a...)
+}
+
+/*
+BeFalse checks that got is false, triggering an error on t if it is not.
+As with BeTrue, the error will include the expression passed as got where available.
+
+The return value will be true if got is false.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeFalse(t TestingT, got bool, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeFalse(got, a...)
+}
+
+/*
+BeTrue checks that got is true, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeTrue
+*/
+func (tester Tester) BeTrue(got bool, a ...interface{}) bool {
+	tester.T.Helper()
+	if got {
+		return true
+	}
+	if expression := callerExpression("BeTrue"); expression != "" {
+		tester.formattedError("expected true: %s", a, expression)
+	} else {
+		tester.formattedError("expected true, got false", a)
+	}
+	return false
+}
+
+/*
+BeFalse checks that got is false, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeFalse
+*/
+func (tester Tester) BeFalse(got bool, a ...interface{}) bool {
+	tester.T.Helper()
+	if !got {
+		return true
+	}
+	if expression := callerExpression("BeFalse"); expression != "" {
+		tester.formattedError("expected false: %s", a, expression)
+	} else {
+		tester.formattedError("expected false, got true", a)
+	}
+	return false
+}
+
+type packageInfo struct {
+	dir        string // Directory containing the source of this package
+	importPath string // Import path of this package
+}
+
+// thisPackage describes the location of this package, for identifying calls into it.
+var thisPackage = func() packageInfo {
+	pc, file, _, _ := runtime.Caller(0)
+	name := runtime.FuncForPC(pc).Name()
+	slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[slash+1:], ".")
+	return packageInfo{
+		dir:        filepath.Dir(file),
+		importPath: name[:slash+1+dot],
+	}
+}()
+
+/*
+callerExpression finds the first call outside of this package, and returns the source of the boolean argument in its call to the function or method called name.
+
+An empty string is returned if the source is unavailable.
+*/
+func callerExpression(name string) string {
+	pcs := make([]uintptr, 50)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		inPackage := filepath.Dir(frame.File) == thisPackage.dir && !strings.HasSuffix(frame.File, "_test.go")
+		if !inPackage {
+			return expressionAt(frame.File, frame.Line, name)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+type parsedFile struct {
+	fset *token.FileSet
+	file *ast.File
+	src  []byte
+}
+
+var (
+	parsedFilesMu sync.Mutex
+	parsedFiles   = make(map[string]*parsedFile)
+)
+
+func parseSource(path string) *parsedFile {
+	parsedFilesMu.Lock()
+	defer parsedFilesMu.Unlock()
+	if parsed, ok := parsedFiles[path]; ok {
+		return parsed
+	}
+	var parsed *parsedFile
+	if src, err := os.ReadFile(path); err == nil {
+		fset := token.NewFileSet()
+		if file, err := parser.ParseFile(fset, path, src, 0); err == nil {
+			parsed = &parsedFile{fset: fset, file: file, src: src}
+		}
+	}
+	parsedFiles[path] = parsed
+	return parsed
+}
+
+// expressionAt returns the source of the boolean argument to the innermost call to name spanning the given line of a file.
+func expressionAt(path string, line int, name string) string {
+	parsed := parseSource(path)
+	if parsed == nil {
+		return ""
+	}
+
+	var found *ast.CallExpr
+	ast.Inspect(parsed.file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		start, end := parsed.fset.Position(call.Pos()).Line, parsed.fset.Position(call.End()).Line
+		if line < start || line > end {
+			return false
+		}
+		if calledName(call.Fun) == name {
+			found = call
+		}
+		return true
+	})
+	if found == nil {
+		return ""
+	}
+
+	// Package level functions take a TestingT before the boolean argument
+	arg := 0
+	if parsed.isPackageFunction(found.Fun) {
+		arg = 1
+	}
+	if arg >= len(found.Args) {
+		return ""
+	}
+	expr := found.Args[arg]
+	return string(parsed.src[parsed.fset.Position(expr.Pos()).Offset:parsed.fset.Position(expr.End()).Offset])
+}
+
+func calledName(fun ast.Expr) string {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		return f.Sel.Name
+	}
+	return ""
+}
+
+// isPackageFunction reports whether fun refers to a function in this package, rather than a method.
+func (parsed *parsedFile) isPackageFunction(fun ast.Expr) bool {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return true
+	case *ast.SelectorExpr:
+		pkg, ok := f.X.(*ast.Ident)
+		if !ok {
+			return false
+		}
+		for _, spec := range parsed.file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || path != thisPackage.importPath {
+				continue
+			}
+			importName := filepath.Base(path)
+			if spec.Name != nil {
+				importName = spec.Name.Name
+			}
+			if importName == pkg.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package must
+
+import "testing"
+
+func TestBeTrue(t *testing.T) {
+	users := []string{"admin"}
+	m := &MockTesting{}
+	tester := Tester{
+		T: m,
+	}
+
+	if !tester.BeTrue(len(users) == 1) {
+		t.Error("Check did not pass as expected")
+	}
+	if m.errorCalled {
+		t.Errorf("Error was raised for a true value: %v", m.args)
+	}
+
+	if tester.BeTrue(len(users) > 3 && users[0] == "admin", "message") {
+		t.Error("Check did not fail as expected")
+	}
+	checkExpression(t, m, "%v: expected true: %s", `len(users) > 3 && users[0] == "admin"`)
+
+	if BeTrue(m,
+		len(users) == 0,
+	) {
+		t.Error("Check did not fail as expected")
+	}
+	checkExpression(t, m, "expected true: %s", `len(users) == 0`)
+}
+
+func TestBeFalse(t *testing.T) {
+	m := &MockTesting{}
+	tester := Tester{
+		T: m,
+	}
+	enabled := true
+
+	if !tester.BeFalse(!enabled) {
+		t.Error("Check did not pass as expected")
+	}
+	if BeFalse(m, enabled || !enabled) {
+		t.Error("Check did not fail as expected")
+	}
+	checkExpression(t, m, "expected false: %s", `enabled || !enabled`)
+
+	// Expressions are found when called through a MustTester, as within a Group
+	All(m, func(mt MustTester) {
+		mt.BeFalse(enabled)
+	})
+	if len(m.args) != 2 || m.args[1] != "1) expected false: enabled" {
+		t.Errorf("Incorrect report, got %v", m.args)
+	}
+}
+
+func TestBeTrueWithoutSource(t *testing.T) {
+	if got := expressionAt("missing_file.go", 1, "BeTrue"); got != "" {
+		t.Errorf("Expected no expression, got %q", got)
+	}
+}
+
+func checkExpression(t *testing.T, m *MockTesting, format, expression string) {
+	t.Helper()
+	if m.format != format {
+		t.Errorf("Incorrect error format. Expected '%v', got '%v'", format, m.format)
+	}
+	if len(m.args) == 0 || m.args[len(m.args)-1] != expression {
+		t.Errorf("Incorrect expression. Expected %q, got %v", expression, m.args)
+	}
+}
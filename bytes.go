@@ -0,0 +1,172 @@
+package must
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	hexRowWidth = 16 // Number of bytes shown on each row of a hex dump
+	hexContext  = 2  // Number of unchanged rows shown either side of a changed row
+)
+
+/*
+BeEqualBytes compares the expected and got byte slices, triggering an error on t if they are not equal.
+Nil and empty slices are considered equal.
+
+The error will include a hex dump of the rows around each difference, in the style of xxd, with the differing bytes marked
+and the offset of the first difference.
+
+The return value will be true if the byte slices are equal.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeEqualBytes(t TestingT, expected, got []byte, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeEqualBytes(expected, got, a...)
+}
+
+/*
+BeEqualBytes compares the expected and got byte slices, triggering an error on the Tester's T if they are not equal.
+
+This corresponds to the function BeEqualBytes
+*/
+func (tester Tester) BeEqualBytes(expected, got []byte, a ...interface{}) bool {
+	tester.T.Helper()
+	if bytes.Equal(expected, got) {
+		return true
+	}
+	tester.formattedError("bytes not equal\n%s", a, tester.style().lines(hexDiff(expected, got)))
+	return false
+}
+
+/*
+hexDiff renders expected and got as a hex dump, showing only the rows that differ and the rows around them.
+Differing rows are shown with expected prefixed by - and got prefixed by +, followed by a line marking the differing bytes.
+*/
+func hexDiff(expected, got []byte) string {
+	first := 0
+	for first < len(expected) && first < len(got) && expected[first] == got[first] {
+		first++
+	}
+
+	header := fmt.Sprintf("first difference at offset 0x%x (%d)", first, first)
+	if len(expected) != len(got) {
+		header += fmt.Sprintf(", expected %d bytes, got %d bytes", len(expected), len(got))
+	}
+	lines := []string{"(- expected, + got)", header}
+
+	rows := (maxInt(len(expected), len(got)) + hexRowWidth - 1) / hexRowWidth
+	changed := make([]bool, rows)
+	for row := range changed {
+		changed[row] = !bytes.Equal(hexRow(expected, row), hexRow(got, row))
+	}
+
+	skipped := 0
+	for row := 0; row < rows; row++ {
+		if !nearChange(changed, row) {
+			skipped++
+			continue
+		}
+		if skipped > 0 {
+			lines = append(lines, skippedRows(skipped))
+			skipped = 0
+		}
+		e, g := hexRow(expected, row), hexRow(got, row)
+		if !changed[row] {
+			lines = append(lines, " "+hexLine(row, e))
+			continue
+		}
+		if len(e) > 0 {
+			lines = append(lines, "-"+hexLine(row, e))
+		}
+		if len(g) > 0 {
+			lines = append(lines, "+"+hexLine(row, g))
+		}
+		lines = append(lines, " "+hexMarks(e, g))
+	}
+	if skipped > 0 {
+		lines = append(lines, skippedRows(skipped))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// skippedRows describes n identical rows omitted from a hex dump.
+func skippedRows(n int) string {
+	if n == 1 {
+		return " ... (1 identical row)"
+	}
+	return fmt.Sprintf(" ... (%d identical rows)", n)
+}
+
+// hexRow returns the bytes of b shown on the given row, which may be empty.
+func hexRow(b []byte, row int) []byte {
+	start, end := row*hexRowWidth, (row+1)*hexRowWidth
+	if start >= len(b) {
+		return nil
+	}
+	if end > len(b) {
+		end = len(b)
+	}
+	return b[start:end]
+}
+
+// nearChange reports whether the row is within hexContext rows of a changed row.
+func nearChange(changed []bool, row int) bool {
+	for i := row - hexContext; i <= row+hexContext; i++ {
+		if i >= 0 && i < len(changed) && changed[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// hexLine formats a row of bytes as an offset, the bytes in hex in groups of two, and the bytes as ASCII.
+func hexLine(row int, b []byte) string {
+	var hex, ascii strings.Builder
+	for i := 0; i < hexRowWidth; i++ {
+		if i > 0 && i%2 == 0 {
+			hex.WriteString(" ")
+		}
+		if i >= len(b) {
+			hex.WriteString("  ")
+			continue
+		}
+		fmt.Fprintf(&hex, "%02x", b[i])
+		if b[i] >= 0x20 && b[i] < 0x7f {
+			ascii.WriteByte(b[i])
+		} else {
+			ascii.WriteByte('.')
+		}
+	}
+	return fmt.Sprintf("%08x: %s  %s", row*hexRowWidth, hex.String(), ascii.String())
+}
+
+// hexMarks returns a line marking the columns of hexLine that differ between the rows e and g.
+func hexMarks(e, g []byte) string {
+	var hex, ascii strings.Builder
+	hex.WriteString(strings.Repeat(" ", len("00000000: ")))
+	for i := 0; i < hexRowWidth; i++ {
+		if i > 0 && i%2 == 0 {
+			hex.WriteString(" ")
+		}
+		differs := i < maxInt(len(e), len(g)) && (i >= len(e) || i >= len(g) || e[i] != g[i])
+		if differs {
+			hex.WriteString("^^")
+			ascii.WriteString("^")
+		} else {
+			hex.WriteString("  ")
+			ascii.WriteString(" ")
+		}
+	}
+	return strings.TrimRight(hex.String()+"  "+ascii.String(), " ")
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,133 @@
+package must
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHexDiff(t *testing.T) {
+	sequence := func(n int) []byte {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = byte(i)
+		}
+		return b
+	}
+	changed := sequence(128)
+	changed[0x45] = 'A'
+
+	var tests = []struct {
+		name     string
+		expected []byte
+		got      []byte
+		out      string
+	}{
+		{
+			name:     "changed byte",
+			expected: []byte("hello, world\x00\x01"),
+			got:      []byte("hello, World\x00\x02"),
+			out: strings.Join([]string{
+				"(- expected, + got)",
+				"first difference at offset 0x7 (7)",
+				"-00000000: 6865 6c6c 6f2c 2077 6f72 6c64 0001       hello, world..",
+				"+00000000: 6865 6c6c 6f2c 2057 6f72 6c64 0002       hello, World..",
+				"                            ^^             ^^              ^     ^",
+			}, "\n"),
+		},
+		{
+			name:     "only context rows are shown",
+			expected: sequence(128),
+			got:      changed,
+			out: strings.Join([]string{
+				"(- expected, + got)",
+				"first difference at offset 0x45 (69)",
+				" ... (2 identical rows)",
+				" 00000020: 2021 2223 2425 2627 2829 2a2b 2c2d 2e2f   !\"#$%&'()*+,-./",
+				" 00000030: 3031 3233 3435 3637 3839 3a3b 3c3d 3e3f  0123456789:;<=>?",
+				"-00000040: 4041 4243 4445 4647 4849 4a4b 4c4d 4e4f  @ABCDEFGHIJKLMNO",
+				"+00000040: 4041 4243 4441 4647 4849 4a4b 4c4d 4e4f  @ABCDAFGHIJKLMNO",
+				"                       ^^                                ^",
+				" 00000050: 5051 5253 5455 5657 5859 5a5b 5c5d 5e5f  PQRSTUVWXYZ[\\]^_",
+				" 00000060: 6061 6263 6465 6667 6869 6a6b 6c6d 6e6f  `abcdefghijklmno",
+				" ... (1 identical row)",
+			}, "\n"),
+		},
+		{
+			name:     "got is shorter",
+			expected: []byte("abcdefghijklmnopqr"),
+			got:      []byte("abcdefghijklmnop"),
+			out: strings.Join([]string{
+				"(- expected, + got)",
+				"first difference at offset 0x10 (16), expected 18 bytes, got 16 bytes",
+				" 00000000: 6162 6364 6566 6768 696a 6b6c 6d6e 6f70  abcdefghijklmnop",
+				"-00000010: 7172                                     qr",
+				"           ^^^^                                     ^^",
+			}, "\n"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if out := hexDiff(test.expected, test.got); out != test.out {
+				t.Errorf("Incorrect output. Expected:\n%s\ngot:\n%s", test.out, out)
+			}
+		})
+	}
+}
+
+func TestBeEqualBytes(t *testing.T) {
+	var tests = []struct {
+		name       string
+		expected   []byte
+		got        []byte
+		shouldPass bool
+	}{
+		{
+			name:       "equal",
+			expected:   []byte{0xde, 0xad, 0xbe, 0xef},
+			got:        []byte{0xde, 0xad, 0xbe, 0xef},
+			shouldPass: true,
+		},
+		{
+			name:       "nil and empty",
+			expected:   nil,
+			got:        []byte{},
+			shouldPass: true,
+		},
+		{
+			name:     "different",
+			expected: []byte{0xde, 0xad, 0xbe, 0xef},
+			got:      []byte{0xde, 0xad, 0xbe, 0xee},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeEqualBytes(test.expected, test.got)
+			var format string
+			if !test.shouldPass {
+				format = "bytes not equal\n%s"
+			}
+			checkResults(t, test.shouldPass, result, format, m)
+		})
+	}
+}
+
+func TestBeEqualDiffsBinaryAsHex(t *testing.T) {
+	m := &MockTesting{}
+	tester := Tester{T: m, Color: ColorNever}
+	tester.BeEqual([]byte{0x89, 'P', 'N', 'G'}, []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a})
+
+	expected := strings.Join([]string{
+		"(- expected, + got)",
+		"first difference at offset 0x4 (4), expected 4 bytes, got 6 bytes",
+		"-00000000: 8950 4e47                                .PNG",
+		"+00000000: 8950 4e47 0d0a                           .PNG..",
+		"                     ^^^^                               ^^",
+	}, "\n")
+	if len(m.args) != 1 || m.args[0] != expected {
+		t.Errorf("Incorrect output. Expected:\n%s\ngot:\n%v", expected, m.args)
+	}
+}
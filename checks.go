@@ -3,6 +3,8 @@ package must
 /*
 BeEqual compares the expected and got interfaces, triggering an error on t if they are not equal.
 This error will include a diff of the two objects.
+Single-line strings and byte slices are diffed character by character, with removed text marked [-like this-] and added text {+like this+}.
+Other byte slices are diffed as a hex dump, as with BeEqualBytes.
 
 The return value will be true if the interfaces are equal.
 
@@ -34,6 +36,7 @@ func BeEqualErrors(t TestingT, expected, got error, a ...interface{}) bool {
 BeNoError checks whether or not the got value is an error.
 
 The return value will be true if got is nil.
+An error holding a nil pointer, such as (*MyError)(nil), is not equal to nil, and will be reported as such.
 
 Additional output for any error message can be provided as additional parameters, as with fmt.Print.
 */
@@ -56,10 +59,79 @@ func BeSameLength(t TestingT, expected, got interface{}, a ...interface{}) bool
 	return mt.BeSameLength(expected, got, a...)
 }
 
+/*
+BeLength checks whether got has the expected length according to the len function.
+Nil values, including nil pointers to types with a length, are considered to have a length of zero.
+
+The return value will be true if the length matches.
+
+Should the length not match, the error will include a preview of the contents of got.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeLength(t TestingT, expected int, got interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeLength(expected, got, a...)
+}
+
+/*
+BeEmpty checks whether got has a length of zero according to the len function, treating nil values as empty.
+
+The return value will be true if got is empty.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeEmpty(t TestingT, got interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeEmpty(got, a...)
+}
+
+/*
+BeNotEmpty checks whether got has a length greater than zero according to the len function.
+
+The return value will be true if got is not empty.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeNotEmpty(t TestingT, got interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeNotEmpty(got, a...)
+}
+
+/*
+BeNil checks that got is nil, including when it is an interface holding a nil pointer, map, slice, channel or function.
+
+The return value will be true if got is nil.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeNil(t TestingT, got interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeNil(got, a...)
+}
+
+/*
+BeNotNil checks that got is not nil, and is not an interface holding a nil pointer, map, slice, channel or function.
+
+The return value will be true if got is not nil.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeNotNil(t TestingT, got interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeNotNil(got, a...)
+}
+
 /*
 BeError checks that the provided error is not nil
 
-The return value will be true iff error is not nil
+The return value will be true iff error is not nil.
+An error holding a nil pointer, such as (*MyError)(nil), is not considered to be an error, and will be reported as a likely mistake.
 
 Additional output for any error message can be provided as additional parameters, as with fmt.Print.
 */
@@ -82,3 +154,64 @@ func BeErrorIf(t TestingT, errorExpected bool, got error, a ...interface{}) bool
 	mt := Tester{T: t}
 	return mt.BeErrorIf(errorExpected, got, a...)
 }
+
+/*
+BeErrorIs checks that target is found in the chain of errors wrapped by got, according to errors.Is.
+
+The return value will be true if target is in the chain.
+
+Should target not be found, the error raised in t will include each error in the chain of got, with its type and message.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeErrorIs(t TestingT, target, got error, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeErrorIs(target, got, a...)
+}
+
+/*
+BeErrorAs checks that an error in the chain of errors wrapped by got can be assigned to target, according to errors.As.
+The target must be a non-nil pointer to either a type implementing error, or to an interface type.
+
+The return value will be true if a matching error was found, in which case target will be set to that error.
+
+Should no matching error be found, the error raised in t will include each error in the chain of got, with its type and message.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeErrorAs(t TestingT, got error, target interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeErrorAs(got, target, a...)
+}
+
+/*
+BeErrorContaining checks that got is not nil and that its error message contains substr.
+
+The return value will be true if the message contains substr.
+
+Should the message not contain substr, the error raised in t will include the message with the closest partial match marked.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeErrorContaining(t TestingT, substr string, got error, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeErrorContaining(substr, got, a...)
+}
+
+/*
+BeErrorMatching checks that got is not nil and that its error message matches the regular expression pattern.
+
+The return value will be true if the message matches pattern.
+
+Should the message not match, the error raised in t will include the message with the match for the longest valid prefix of pattern marked.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeErrorMatching(t TestingT, pattern string, got error, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeErrorMatching(pattern, got, a...)
+}
@@ -0,0 +1,375 @@
+package must
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+/*
+BeContaining checks that container includes element, triggering an error on t if it does not.
+
+For slices and arrays, element is compared against each item in the same way as BeEqual. For maps, element is compared against the keys.
+For strings, element may be a string to find as a substring, or a single rune.
+Pointers to any of these types are also supported.
+
+The return value will be true if container includes element.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeContaining(t TestingT, container, element interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeContaining(container, element, a...)
+}
+
+/*
+BeNotContaining checks that container does not include element, triggering an error on t if it does.
+Elements are found as with BeContaining.
+
+The return value will be true if container does not include element.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeNotContaining(t TestingT, container, element interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeNotContaining(container, element, a...)
+}
+
+/*
+BeSubsetOf checks that every element of subset is included in superset, triggering an error on t listing any missing elements.
+Elements are found as with BeContaining, with strings treated as collections of characters.
+
+The return value will be true if every element of subset is in superset.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeSubsetOf(t TestingT, subset, superset interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeSubsetOf(subset, superset, a...)
+}
+
+/*
+BeEqualElements checks that expected and got contain the same elements, in any order, triggering an error on t if they do not.
+Elements are compared in the same way as BeEqual, and each must appear the same number of times in both collections.
+Collections are read as with BeSubsetOf, except that the elements of a map are its key-value pairs, so values must match as well as keys.
+
+Should the elements differ, the error will list the elements that are missing, extra, or appear a different number of times.
+
+The return value will be true if the collections contain the same elements.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeEqualElements(t TestingT, expected, got interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeEqualElements(expected, got, a...)
+}
+
+/*
+BeContaining checks that container includes element, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeContaining
+*/
+func (tester Tester) BeContaining(container, element interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	found, _, err := tester.find(container, element)
+	if err != nil {
+		tester.formattedError("could not check contents - %v", a, err)
+		return false
+	}
+	if found {
+		return true
+	}
+	tester.formattedError("expected %s to contain %s", a, formatCompact(container), formatCompact(element))
+	return false
+}
+
+/*
+BeNotContaining checks that container does not include element, triggering an error on the Tester's T if it does.
+
+This corresponds to the function BeNotContaining
+*/
+func (tester Tester) BeNotContaining(container, element interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	found, location, err := tester.find(container, element)
+	if err != nil {
+		tester.formattedError("could not check contents - %v", a, err)
+		return false
+	}
+	if !found {
+		return true
+	}
+	tester.formattedError("expected %s not to contain %s, found at %s", a, formatCompact(container), formatCompact(element), location)
+	return false
+}
+
+/*
+BeSubsetOf checks that every element of subset is included in superset, triggering an error on the Tester's T if any are missing.
+
+This corresponds to the function BeSubsetOf
+*/
+func (tester Tester) BeSubsetOf(subset, superset interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	elements, err := collectionElements(subset)
+	if err != nil {
+		tester.formattedError("could not check contents - %v", a, err)
+		return false
+	}
+	var missing []string
+	for _, element := range elements {
+		found, _, err := tester.find(superset, element)
+		if err != nil {
+			tester.formattedError("could not check contents - %v", a, err)
+			return false
+		}
+		if !found {
+			missing = append(missing, formatCompact(element))
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+	tester.formattedError("elements missing from %s:\n  %s", a, formatCompact(superset), strings.Join(missing, "\n  "))
+	return false
+}
+
+/*
+BeEqualElements checks that expected and got contain the same elements in any order, triggering an error on the Tester's T if they do not.
+
+This corresponds to the function BeEqualElements
+*/
+func (tester Tester) BeEqualElements(expected, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	expectedElements, err := entryElements(expected)
+	if err != nil {
+		tester.formattedError("could not check contents - %v", a, err)
+		return false
+	}
+	gotElements, err := entryElements(got)
+	if err != nil {
+		tester.formattedError("could not check contents - %v", a, err)
+		return false
+	}
+
+	counts := tester.countElements(expectedElements, gotElements)
+	var report []string
+	for _, count := range counts {
+		value := formatCompact(count.value)
+		switch {
+		case count.expected == count.got:
+			continue
+		case count.got == 0:
+			report = append(report, fmt.Sprintf("missing: %s%s", value, times(count.expected)))
+		case count.expected == 0:
+			report = append(report, fmt.Sprintf("extra: %s%s", value, times(count.got)))
+		default:
+			report = append(report, fmt.Sprintf("%s: expected count %d, got %d", value, count.expected, count.got))
+		}
+	}
+	if len(report) == 0 {
+		return true
+	}
+	tester.formattedError("elements not equal\n  %s", a, strings.Join(report, "\n  "))
+	return false
+}
+
+type elementCount struct {
+	value    interface{}
+	expected int
+	got      int
+}
+
+// countElements groups equal elements, counting the number of times each appears in expected and got.
+func (tester Tester) countElements(expected, got []interface{}) []*elementCount {
+	var counts []*elementCount
+	lookup := func(element interface{}) *elementCount {
+		for _, count := range counts {
+			if tester.equal(count.value, element) {
+				return count
+			}
+		}
+		count := &elementCount{value: element}
+		counts = append(counts, count)
+		return count
+	}
+	for _, element := range expected {
+		lookup(element).expected++
+	}
+	for _, element := range got {
+		lookup(element).got++
+	}
+	return counts
+}
+
+func times(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return fmt.Sprintf(" (%d times)", n)
+}
+
+// find searches container for element, returning a description of the location of the first match.
+func (tester Tester) find(container, element interface{}) (bool, string, error) {
+	if s, ok := container.(string); ok {
+		return findInString(s, element)
+	}
+	val, err := collectionValue(container)
+	if err != nil {
+		return false, "", err
+	}
+	if val.Kind() == reflect.String {
+		return findInString(val.String(), element)
+	}
+	if val.Kind() == reflect.Map {
+		for _, key := range sortedKeys(val) {
+			if tester.equal(key.Interface(), element) {
+				return true, "key " + formatKey(key), nil
+			}
+		}
+		return false, "", nil
+	}
+	for i := 0; i < val.Len(); i++ {
+		if tester.equal(val.Index(i).Interface(), element) {
+			return true, fmt.Sprintf("index %d", i), nil
+		}
+	}
+	return false, "", nil
+}
+
+func findInString(s string, element interface{}) (bool, string, error) {
+	var substr string
+	switch e := element.(type) {
+	case string:
+		substr = e
+	case rune:
+		substr = string(e)
+	default:
+		return false, "", fmt.Errorf("cannot search a string for type: %T", element)
+	}
+	if i := strings.Index(s, substr); i >= 0 {
+		return true, fmt.Sprintf("index %d", i), nil
+	}
+	return false, "", nil
+}
+
+// collectionValue resolves val to a slice, array, map or string, dereferencing pointers.
+func collectionValue(val interface{}) (reflect.Value, error) {
+	if val == nil {
+		return reflect.Value{}, errors.New("cannot get the elements of nil")
+	}
+	v := reflect.ValueOf(val)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, fmt.Errorf("cannot get the elements of a nil pointer to type: %v", v.Type().Elem())
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String:
+		return v, nil
+	case reflect.Chan:
+		return reflect.Value{}, errors.New("cannot get the elements of a channel without receiving them")
+	}
+	return reflect.Value{}, fmt.Errorf("cannot get the elements of type: %v", v.Kind())
+}
+
+// collectionElements returns the elements of a slice or array, the keys of a map or the characters of a string.
+func collectionElements(val interface{}) ([]interface{}, error) {
+	v, err := collectionValue(val)
+	if err != nil {
+		return nil, err
+	}
+	var elements []interface{}
+	switch v.Kind() {
+	case reflect.String:
+		for _, r := range v.String() {
+			elements = append(elements, string(r))
+		}
+	case reflect.Map:
+		for _, key := range sortedKeys(v) {
+			elements = append(elements, key.Interface())
+		}
+	default:
+		for i := 0; i < v.Len(); i++ {
+			elements = append(elements, v.Index(i).Interface())
+		}
+	}
+	return elements, nil
+}
+
+// mapEntry is a key-value pair from a map, used as an element when the values of a map matter.
+type mapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// entryElements returns the elements of val as with collectionElements, but with maps returning their key-value pairs.
+func entryElements(val interface{}) ([]interface{}, error) {
+	v, err := collectionValue(val)
+	if err != nil {
+		return nil, err
+	}
+	if v.Kind() != reflect.Map {
+		return collectionElements(val)
+	}
+	var elements []interface{}
+	for _, key := range sortedKeys(v) {
+		elements = append(elements, mapEntry{Key: key.Interface(), Value: v.MapIndex(key).Interface()})
+	}
+	return elements, nil
+}
+
+// formatCompact renders a value on a single line.
+func formatCompact(val interface{}) string {
+	if val == nil {
+		return "nil"
+	}
+	if entry, ok := val.(mapEntry); ok {
+		return fmt.Sprintf("%s: %s", formatCompact(entry.Key), formatCompact(entry.Value))
+	}
+	return formatCompactValue(reflect.ValueOf(val))
+}
+
+// previewLimit is the maximum number of elements, or characters of a string, shown by preview.
+const previewLimit = 10
+
+// preview renders the contents of a collection on a single line, truncated to the first previewLimit elements.
+func preview(val interface{}) string {
+	v, err := collectionValue(val)
+	if err != nil {
+		return formatCompact(val)
+	}
+	switch v.Kind() {
+	case reflect.String:
+		runes := []rune(v.String())
+		if len(runes) <= previewLimit {
+			return fmt.Sprintf("%q", v.String())
+		}
+		return fmt.Sprintf("%q... (%d more characters)", string(runes[:previewLimit]), len(runes)-previewLimit)
+	case reflect.Map:
+		var entries []string
+		for _, key := range sortedKeys(v) {
+			if len(entries) == previewLimit {
+				break
+			}
+			entries = append(entries, formatCompactValue(key)+": "+formatCompactValue(v.MapIndex(key)))
+		}
+		return "{" + strings.Join(entries, ", ") + more(v.Len()) + "}"
+	}
+	var elements []string
+	for i := 0; i < v.Len() && i < previewLimit; i++ {
+		elements = append(elements, formatCompactValue(v.Index(i)))
+	}
+	return "[" + strings.Join(elements, ", ") + more(v.Len()) + "]"
+}
+
+func more(length int) string {
+	if length <= previewLimit {
+		return ""
+	}
+	return fmt.Sprintf(", ... (%d more)", length-previewLimit)
+}
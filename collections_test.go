@@ -0,0 +1,291 @@
+package must
+
+import "testing"
+
+func TestBeContaining(t *testing.T) {
+	var tests = []struct {
+		name       string
+		container  interface{}
+		element    interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "slice containing element",
+			container:  []int{1, 2, 3},
+			element:    2,
+			shouldPass: true,
+		},
+		{
+			name:      "slice not containing element",
+			container: []int{1, 2, 3},
+			element:   4,
+			format:    "expected %s to contain %s",
+		},
+		{
+			name:       "array of structs",
+			container:  [2]compareItem{{Price: 1}, {Price: 2}},
+			element:    compareItem{Price: 2},
+			shouldPass: true,
+		},
+		{
+			name:       "map key",
+			container:  map[string]int{"a": 1},
+			element:    "a",
+			shouldPass: true,
+		},
+		{
+			name:      "map value is not a key",
+			container: map[string]int{"a": 1},
+			element:   1,
+			format:    "expected %s to contain %s",
+		},
+		{
+			name:       "substring",
+			container:  "hello world",
+			element:    "o w",
+			shouldPass: true,
+		},
+		{
+			name:       "rune in string pointer",
+			container:  stringToPointer("hello"),
+			element:    'e',
+			shouldPass: true,
+		},
+		{
+			name:      "string and int",
+			container: "hello",
+			element:   1,
+			format:    "could not check contents - %v",
+		},
+		{
+			name:      "channel",
+			container: make(chan int),
+			element:   1,
+			format:    "could not check contents - %v",
+		},
+		{
+			name:      "nil",
+			container: nil,
+			element:   1,
+			format:    "could not check contents - %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeContaining(test.container, test.element)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestBeContainingCustomCompare(t *testing.T) {
+	m := &MockTesting{}
+	tester := Tester{
+		T: m,
+		InterfaceComparison: func(expected, got interface{}) bool {
+			return expected == 10 && got == 1
+		},
+	}
+	if !tester.BeContaining([]int{10}, 1) {
+		t.Error("Custom comparison was not used")
+	}
+}
+
+func TestBeNotContaining(t *testing.T) {
+	m := &MockTesting{}
+	if !BeNotContaining(m, []string{"a", "b"}, "c") {
+		t.Error("Check did not pass as expected")
+	}
+	if BeNotContaining(m, []string{"a", "b"}, "b") {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "expected %s not to contain %s, found at %s" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+	if len(m.args) != 3 || m.args[2] != "index 1" {
+		t.Errorf("Incorrect error args, got %v", m.args)
+	}
+}
+
+func TestBeSubsetOf(t *testing.T) {
+	var tests = []struct {
+		name       string
+		subset     interface{}
+		superset   interface{}
+		shouldPass bool
+		missing    string
+	}{
+		{
+			name:       "slice subset",
+			subset:     []int{3, 1},
+			superset:   []int{1, 2, 3},
+			shouldPass: true,
+		},
+		{
+			name:     "slice not subset",
+			subset:   []int{3, 4, 5},
+			superset: []int{1, 2, 3},
+			missing:  "4\n  5",
+		},
+		{
+			name:       "map keys in slice",
+			subset:     map[string]bool{"a": true},
+			superset:   []string{"a", "b"},
+			shouldPass: true,
+		},
+		{
+			name:       "string characters",
+			subset:     "cab",
+			superset:   "abc",
+			shouldPass: true,
+		},
+		{
+			name:     "string characters missing",
+			subset:   "abd",
+			superset: "abc",
+			missing:  `"d"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeSubsetOf(test.subset, test.superset)
+			if result != test.shouldPass {
+				t.Errorf("result not as expected: expected %v, got %v", test.shouldPass, result)
+			}
+			if test.shouldPass {
+				return
+			}
+			if m.format != "elements missing from %s:\n  %s" || len(m.args) != 2 {
+				t.Fatalf("Incorrect error, got format '%v' with args %v", m.format, m.args)
+			}
+			if m.args[1] != test.missing {
+				t.Errorf("Incorrect missing elements. Expected %q, got %q", test.missing, m.args[1])
+			}
+		})
+	}
+}
+
+func TestBeEqualElements(t *testing.T) {
+	var tests = []struct {
+		name       string
+		expected   interface{}
+		got        interface{}
+		shouldPass bool
+		format     string
+		report     string
+	}{
+		{
+			name:       "same order",
+			expected:   []int{1, 2, 3},
+			got:        []int{1, 2, 3},
+			shouldPass: true,
+		},
+		{
+			name:       "different order",
+			expected:   []string{"a", "b", "b", "c"},
+			got:        []string{"b", "c", "a", "b"},
+			shouldPass: true,
+		},
+		{
+			name:       "slice and array",
+			expected:   []compareItem{{Price: 1}, {Price: 2}},
+			got:        [2]compareItem{{Price: 2}, {Price: 1}},
+			shouldPass: true,
+		},
+		{
+			name:     "missing, extra and different counts",
+			expected: []string{"a", "b", "b", "c", "d", "d"},
+			got:      []string{"e", "b", "a", "a", "f", "f"},
+			format:   "elements not equal\n  %s",
+			report: "\"a\": expected count 1, got 2\n" +
+				"  \"b\": expected count 2, got 1\n" +
+				"  missing: \"c\"\n" +
+				"  missing: \"d\" (2 times)\n" +
+				"  extra: \"e\"\n" +
+				"  extra: \"f\" (2 times)",
+		},
+		{
+			name:     "not a collection",
+			expected: []int{1},
+			got:      1,
+			format:   "could not check contents - %v",
+		},
+		{
+			name:       "maps",
+			expected:   map[string]int{"a": 1, "b": 2},
+			got:        map[string]int{"b": 2, "a": 1},
+			shouldPass: true,
+		},
+		{
+			name:     "maps with same keys and different values",
+			expected: map[string]int{"a": 1},
+			got:      map[string]int{"a": 2},
+			format:   "elements not equal\n  %s",
+			report: "missing: \"a\": 1\n" +
+				"  extra: \"a\": 2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeEqualElements(test.expected, test.got)
+			checkResults(t, test.shouldPass, result, test.format, m)
+			if test.report != "" && (len(m.args) != 1 || m.args[0] != test.report) {
+				t.Errorf("Incorrect report. Expected:\n%s\ngot:\n%v", test.report, m.args)
+			}
+		})
+	}
+}
+
+func TestPreview(t *testing.T) {
+	var tests = []struct {
+		name     string
+		val      interface{}
+		expected string
+	}{
+		{
+			name:     "short slice",
+			val:      []int{1, 2, 3},
+			expected: "[1, 2, 3]",
+		},
+		{
+			name:     "long slice",
+			val:      []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			expected: "[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, ... (2 more)]",
+		},
+		{
+			name:     "map",
+			val:      map[string]int{"b": 2, "a": 1},
+			expected: `{"a": 1, "b": 2}`,
+		},
+		{
+			name:     "long string",
+			val:      "abcdefghijklmnop",
+			expected: `"abcdefghij"... (6 more characters)`,
+		},
+		{
+			name:     "nil",
+			val:      nil,
+			expected: "nil",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := preview(test.val); got != test.expected {
+				t.Errorf("Incorrect preview. Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package must
+
+import (
+	"os"
+	"strings"
+)
+
+/*
+ColorMode controls whether a Tester colors its failure output with ANSI escape sequences.
+*/
+type ColorMode int
+
+const (
+	// ColorAuto colors output when stdout is a terminal, unless overridden by the MUST_COLOR or NO_COLOR environment variables
+	ColorAuto ColorMode = iota
+	// ColorAlways colors output regardless of the environment
+	ColorAlways
+	// ColorNever never colors output
+	ColorNever
+)
+
+const (
+	colorEnv   = "MUST_COLOR" // Set to "always" or "never" to override terminal detection. Takes precedence over NO_COLOR
+	noColorEnv = "NO_COLOR"   // Disables color when set to any non-empty value, see https://no-color.org
+
+	ansiReset   = "\x1b[0m"
+	ansiBold    = "\x1b[1m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+	ansiRedBg   = "\x1b[41m"
+	ansiGreenBg = "\x1b[42m"
+)
+
+// lookupEnv and stdoutIsTerminal are variables so the environment can be replaced in tests.
+var (
+	lookupEnv        = os.LookupEnv
+	stdoutIsTerminal = func() bool {
+		info, err := os.Stdout.Stat()
+		return err == nil && info.Mode()&os.ModeCharDevice != 0
+	}
+)
+
+// enabled reports whether output should be colored in this mode, given the current environment.
+func (mode ColorMode) enabled() bool {
+	switch mode {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+	if value, ok := lookupEnv(colorEnv); ok {
+		switch strings.ToLower(value) {
+		case "always", "1", "true", "yes":
+			return true
+		case "never", "0", "false", "no":
+			return false
+		}
+	}
+	if value, ok := lookupEnv(noColorEnv); ok && value != "" {
+		return false
+	}
+	return stdoutIsTerminal()
+}
+
+// styler applies ANSI colors to failure output, or leaves it unchanged when color is disabled.
+type styler struct {
+	color bool
+}
+
+// style returns the styler for the Tester's ColorMode.
+func (tester Tester) style() styler {
+	return styler{color: tester.Color.enabled()}
+}
+
+func (s styler) apply(code, text string) string {
+	if !s.color || text == "" {
+		return text
+	}
+	return code + text + ansiReset
+}
+
+func (s styler) header(text string) string {
+	return s.apply(ansiBold, text)
+}
+
+func (s styler) removed(text string) string {
+	return s.apply(ansiRed, text)
+}
+
+func (s styler) added(text string) string {
+	return s.apply(ansiGreen, text)
+}
+
+// removedInline and addedInline highlight changed runs within a line, so they stand out from the unchanged text around them.
+func (s styler) removedInline(text string) string {
+	return s.apply(ansiRedBg, text)
+}
+
+func (s styler) addedInline(text string) string {
+	return s.apply(ansiGreenBg, text)
+}
+
+/*
+lines colors a line-based diff: lines starting with - are removed, lines starting with + are added,
+and other lines that are not indented, such as "(- expected, + got)", are headers.
+*/
+func (s styler) lines(diff string) string {
+	if !s.color {
+		return diff
+	}
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			lines[i] = s.removed(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = s.added(line)
+		case line != "" && line[0] != ' ' && line[0] != '\t':
+			lines[i] = s.header(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,150 @@
+package must
+
+import (
+	"testing"
+)
+
+// withEnvironment replaces the environment variables and terminal detection used to choose colors for the duration of a test.
+func withEnvironment(t *testing.T, env map[string]string, terminal bool) {
+	t.Helper()
+	oldLookupEnv, oldStdoutIsTerminal := lookupEnv, stdoutIsTerminal
+	lookupEnv = func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+	stdoutIsTerminal = func() bool { return terminal }
+	t.Cleanup(func() {
+		lookupEnv, stdoutIsTerminal = oldLookupEnv, oldStdoutIsTerminal
+	})
+}
+
+func TestColorModeEnabled(t *testing.T) {
+	var tests = []struct {
+		name     string
+		mode     ColorMode
+		env      map[string]string
+		terminal bool
+		expected bool
+	}{
+		{
+			name:     "auto on terminal",
+			terminal: true,
+			expected: true,
+		},
+		{
+			name:     "auto without terminal",
+			expected: false,
+		},
+		{
+			name:     "NO_COLOR on terminal",
+			env:      map[string]string{"NO_COLOR": "1"},
+			terminal: true,
+			expected: false,
+		},
+		{
+			name:     "empty NO_COLOR is ignored",
+			env:      map[string]string{"NO_COLOR": ""},
+			terminal: true,
+			expected: true,
+		},
+		{
+			name:     "MUST_COLOR always without terminal",
+			env:      map[string]string{"MUST_COLOR": "always"},
+			expected: true,
+		},
+		{
+			name:     "MUST_COLOR takes precedence over NO_COLOR",
+			env:      map[string]string{"MUST_COLOR": "always", "NO_COLOR": "1"},
+			expected: true,
+		},
+		{
+			name:     "MUST_COLOR never on terminal",
+			env:      map[string]string{"MUST_COLOR": "never"},
+			terminal: true,
+			expected: false,
+		},
+		{
+			name:     "unrecognized MUST_COLOR falls back to detection",
+			env:      map[string]string{"MUST_COLOR": "auto"},
+			terminal: true,
+			expected: true,
+		},
+		{
+			name:     "always ignores environment",
+			mode:     ColorAlways,
+			env:      map[string]string{"MUST_COLOR": "never", "NO_COLOR": "1"},
+			expected: true,
+		},
+		{
+			name:     "never ignores environment",
+			mode:     ColorNever,
+			env:      map[string]string{"MUST_COLOR": "always"},
+			terminal: true,
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			withEnvironment(t, test.env, test.terminal)
+			if got := test.mode.enabled(); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestColoredDiff(t *testing.T) {
+	type item struct {
+		Name  string
+		Count int
+	}
+	var tests = []struct {
+		name     string
+		color    ColorMode
+		expected interface{}
+		got      interface{}
+		rendered string
+	}{
+		{
+			name:     "lines",
+			color:    ColorAlways,
+			expected: "a\nb",
+			got:      "a\nc",
+			rendered: "\x1b[1m(- expected, + got)\x1b[0m\n a\n\x1b[31m-b\x1b[0m\n\x1b[32m+c\x1b[0m",
+		},
+		{
+			name:     "inline",
+			color:    ColorAlways,
+			expected: "id=123",
+			got:      "id=124",
+			rendered: "\x1b[1m([-expected-], {+got+})\x1b[0m\nid=12\x1b[41m[-3-]\x1b[0m\x1b[42m{+4+}\x1b[0m",
+		},
+		{
+			name:     "structs",
+			color:    ColorAlways,
+			expected: item{Name: "a", Count: 1},
+			got:      item{Name: "a", Count: 2},
+			rendered: "\x1b[1m(- expected, + got)\x1b[0m\n {\n  Name: \"a\",\n\x1b[31m- Count: 1,\x1b[0m\n\x1b[32m+ Count: 2,\x1b[0m\n }",
+		},
+		{
+			name:     "disabled",
+			color:    ColorNever,
+			expected: "a\nb",
+			got:      "a\nc",
+			rendered: "(- expected, + got)\n a\n-b\n+c",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T:     m,
+				Color: test.color,
+			}
+			tester.BeEqual(test.expected, test.got)
+			if len(m.args) != 1 || m.args[0] != test.rendered {
+				t.Errorf("Incorrect output. Expected:\n%q\ngot:\n%q", test.rendered, m.args)
+			}
+		})
+	}
+}
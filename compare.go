@@ -0,0 +1,440 @@
+package must
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+)
+
+/*
+CompareOptions configures how a Tester compares values for BeEqual and related checks.
+
+The zero value compares values exactly. Any options that are set are applied to both the comparison and the diff, so the diff only shows the differences that caused a check to fail.
+*/
+type CompareOptions struct {
+	IgnoreFields     []string    // Paths of struct fields to ignore, such as ".Meta.Updated". Use [*] to match any index or key, as in ".Items[*].ID"
+	IgnoreUnexported bool        // Ignore unexported struct fields
+	UnorderedSlices  bool        // Compare slices and arrays as sets of elements, ignoring their order
+	NilEqualsEmpty   bool        // Treat nil slices and maps as equal to empty ones
+	FloatTolerance   float64     // Maximum absolute difference between floating point or complex values for them to be equal
+	TimeByInstant    bool        // Compare time.Time values by the instant they represent, ignoring location and monotonic clock readings. Times in unexported fields cannot be read, so are compared as they are without this option
+	Text             TextOptions // Normalization applied to strings before they are compared
+}
+
+// DiffStyle selects how a Tester renders the differences between two values.
+type DiffStyle int
+
+const (
+	// DiffPretty renders a line-by-line diff of the pretty-printed values. This is the default.
+	DiffPretty DiffStyle = iota
+	// DiffPaths renders one line per difference, annotated with the path to that difference,
+	// such as: .Orders[3].Items["sku"].Price: expected 10, got 12
+	DiffPaths
+)
+
+func (options CompareOptions) isZero() bool {
+	return len(options.IgnoreFields) == 0 &&
+		!options.IgnoreUnexported &&
+		!options.UnorderedSlices &&
+		!options.NilEqualsEmpty &&
+		options.FloatTolerance == 0 &&
+		!options.TimeByInstant &&
+		options.Text == (TextOptions{})
+}
+
+// difference describes a single point at which two values differ.
+// An invalid expected or got value indicates that the element was not present on that side.
+type difference struct {
+	path     string
+	expected reflect.Value
+	got      reflect.Value
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// differences walks expected and got together, returning every point at which they differ according to options.
+func differences(expected, got interface{}, options CompareOptions) []difference {
+	c := &comparer{
+		options: options,
+		visited: make(map[visit]bool),
+	}
+	c.compare("", "", reflect.ValueOf(expected), reflect.ValueOf(got))
+	return c.diffs
+}
+
+type visit struct {
+	expected uintptr
+	got      uintptr
+	typ      reflect.Type
+}
+
+type comparer struct {
+	options CompareOptions
+	visited map[visit]bool
+	diffs   []difference
+}
+
+func (c *comparer) add(path string, expected, got reflect.Value) {
+	c.diffs = append(c.diffs, difference{path: path, expected: expected, got: got})
+}
+
+// compare records the differences between expected and got.
+// The wildPath matches path with every index and key replaced by [*], for matching against IgnoreFields.
+func (c *comparer) compare(path, wildPath string, expected, got reflect.Value) {
+	if !expected.IsValid() || !got.IsValid() {
+		if expected.IsValid() != got.IsValid() {
+			c.add(path, expected, got)
+		}
+		return
+	}
+	if expected.Type() != got.Type() {
+		if !c.equalAcrossTypes(expected, got) {
+			c.add(path, expected, got)
+		}
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Interface:
+		if expected.IsNil() || got.IsNil() {
+			if expected.IsNil() != got.IsNil() {
+				c.add(path, expected, got)
+			}
+			return
+		}
+		c.compare(path, wildPath, expected.Elem(), got.Elem())
+	case reflect.Ptr:
+		if expected.IsNil() || got.IsNil() {
+			if expected.IsNil() != got.IsNil() {
+				c.add(path, expected, got)
+			}
+			return
+		}
+		if expected.Pointer() == got.Pointer() {
+			return
+		}
+		v := visit{expected: expected.Pointer(), got: got.Pointer(), typ: expected.Type()}
+		if c.visited[v] {
+			return
+		}
+		c.visited[v] = true
+		c.compare(path, wildPath, expected.Elem(), got.Elem())
+	case reflect.Struct:
+		// Unexported time fields cannot be converted to time.Time, so are compared by their fields
+		if c.options.TimeByInstant && expected.Type() == timeType && expected.CanInterface() {
+			if !expected.Interface().(time.Time).Equal(got.Interface().(time.Time)) {
+				c.add(path, expected, got)
+			}
+			return
+		}
+		for i := 0; i < expected.NumField(); i++ {
+			field := expected.Type().Field(i)
+			if c.options.IgnoreUnexported && field.PkgPath != "" {
+				continue
+			}
+			fieldPath, fieldWildPath := path+"."+field.Name, wildPath+"."+field.Name
+			if c.ignored(fieldPath, fieldWildPath) {
+				continue
+			}
+			c.compare(fieldPath, fieldWildPath, expected.Field(i), got.Field(i))
+		}
+	case reflect.Slice:
+		if expected.IsNil() != got.IsNil() {
+			if !c.options.NilEqualsEmpty || expected.Len() != 0 || got.Len() != 0 {
+				c.add(path, expected, got)
+			}
+			return
+		}
+		c.compareElements(path, wildPath, expected, got)
+	case reflect.Array:
+		c.compareElements(path, wildPath, expected, got)
+	case reflect.Map:
+		if expected.IsNil() != got.IsNil() {
+			if !c.options.NilEqualsEmpty || expected.Len() != 0 || got.Len() != 0 {
+				c.add(path, expected, got)
+			}
+			return
+		}
+		c.compareMaps(path, wildPath, expected, got)
+	case reflect.Float32, reflect.Float64:
+		e, g := expected.Float(), got.Float()
+		if math.IsNaN(e) && math.IsNaN(g) {
+			return
+		}
+		if e != g && !(math.Abs(e-g) <= c.options.FloatTolerance) {
+			c.add(path, expected, got)
+		}
+	case reflect.Complex64, reflect.Complex128:
+		e, g := expected.Complex(), got.Complex()
+		if e != g && !(cmplx.Abs(e-g) <= c.options.FloatTolerance) {
+			c.add(path, expected, got)
+		}
+	case reflect.String:
+		if c.options.Text.normalize(expected.String()) != c.options.Text.normalize(got.String()) {
+			c.add(path, expected, got)
+		}
+	case reflect.Bool:
+		if expected.Bool() != got.Bool() {
+			c.add(path, expected, got)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if expected.Int() != got.Int() {
+			c.add(path, expected, got)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if expected.Uint() != got.Uint() {
+			c.add(path, expected, got)
+		}
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		// The default comparison cannot print these when they are unexported, so treats them as equal
+		if expected.CanInterface() && expected.Pointer() != got.Pointer() {
+			c.add(path, expected, got)
+		}
+	}
+}
+
+/*
+equalAcrossTypes reports whether values of different types are equal, matching the default comparison,
+which treats values as equal when they print the same, such as 1 and int64(1).
+Numbers that differ by no more than FloatTolerance are also equal.
+*/
+func (c *comparer) equalAcrossTypes(expected, got reflect.Value) bool {
+	if isNumber(expected) && isNumber(got) {
+		if formatNumber(expected) == formatNumber(got) {
+			return true
+		}
+		if !isFloat(expected) || !isFloat(got) {
+			return false
+		}
+		e, _, _ := toComplex(expected)
+		g, _, _ := toComplex(got)
+		return cmplx.Abs(e-g) <= c.options.FloatTolerance
+	}
+	if expected.CanInterface() && got.CanInterface() {
+		return pretty.Compare(expected.Interface(), got.Interface()) == ""
+	}
+	return false
+}
+
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// isFloat reports whether v is a floating point or complex number, to which FloatTolerance applies.
+func isFloat(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// formatNumber prints a number as the default comparison does.
+func formatNumber(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%d", v.Int())
+	case reflect.Uintptr:
+		return fmt.Sprintf("0x%X", v.Uint())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%d", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", v.Float())
+	}
+	return fmt.Sprintf("%v", v.Complex())
+}
+
+func (c *comparer) compareElements(path, wildPath string, expected, got reflect.Value) {
+	elementWildPath := wildPath + "[*]"
+	if c.options.UnorderedSlices {
+		unmatchedExpected, unmatchedGot := matchElements(expected.Len(), got.Len(), func(i, j int) bool {
+			sub := &comparer{options: c.options, visited: make(map[visit]bool)}
+			sub.compare("", "", expected.Index(i), got.Index(j))
+			return len(sub.diffs) == 0
+		})
+		for _, i := range unmatchedExpected {
+			c.add(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), reflect.Value{})
+		}
+		for _, j := range unmatchedGot {
+			c.add(fmt.Sprintf("%s[%d]", path, j), reflect.Value{}, got.Index(j))
+		}
+		return
+	}
+
+	for i := 0; i < expected.Len() || i < got.Len(); i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= got.Len():
+			c.add(elementPath, expected.Index(i), reflect.Value{})
+		case i >= expected.Len():
+			c.add(elementPath, reflect.Value{}, got.Index(i))
+		default:
+			c.compare(elementPath, elementWildPath, expected.Index(i), got.Index(i))
+		}
+	}
+}
+
+func (c *comparer) compareMaps(path, wildPath string, expected, got reflect.Value) {
+	elementWildPath := wildPath + "[*]"
+	for _, key := range sortedKeys(expected) {
+		elementPath := path + formatKey(key)
+		gotValue := got.MapIndex(key)
+		if !gotValue.IsValid() {
+			c.add(elementPath, expected.MapIndex(key), reflect.Value{})
+			continue
+		}
+		c.compare(elementPath, elementWildPath, expected.MapIndex(key), gotValue)
+	}
+	for _, key := range sortedKeys(got) {
+		if !expected.MapIndex(key).IsValid() {
+			c.add(path+formatKey(key), reflect.Value{}, got.MapIndex(key))
+		}
+	}
+}
+
+func (c *comparer) ignored(path, wildPath string) bool {
+	for _, field := range c.options.IgnoreFields {
+		if field == path || field == wildPath {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+matchElements pairs elements from two collections of sizes n and m, where equal reports whether element i of the first matches element j of the second.
+
+The indices of any elements left without a match are returned for each collection.
+*/
+func matchElements(n, m int, equal func(i, j int) bool) (unmatchedExpected, unmatchedGot []int) {
+	matched := make([]bool, m)
+	for i := 0; i < n; i++ {
+		found := false
+		for j := 0; j < m; j++ {
+			if !matched[j] && equal(i, j) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmatchedExpected = append(unmatchedExpected, i)
+		}
+	}
+	for j, ok := range matched {
+		if !ok {
+			unmatchedGot = append(unmatchedGot, j)
+		}
+	}
+	return unmatchedExpected, unmatchedGot
+}
+
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	return keys
+}
+
+func formatKey(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return fmt.Sprintf("[%q]", key.String())
+	}
+	return fmt.Sprintf("[%v]", key)
+}
+
+var valueConfig = &pretty.Config{
+	Diffable:          true,
+	IncludeUnexported: true,
+}
+
+var compactValueConfig = &pretty.Config{
+	Compact:           true,
+	IncludeUnexported: true,
+}
+
+// formatValue renders a value for display in a diff, or <missing> for an invalid value.
+func formatValue(val reflect.Value) string {
+	if !val.IsValid() {
+		return "<missing>"
+	}
+	if val.Type() == timeType && val.CanInterface() {
+		return formatTime(val.Interface().(time.Time))
+	}
+	if val.CanInterface() {
+		return valueConfig.Sprint(val.Interface())
+	}
+	return fmt.Sprintf("%v", val)
+}
+
+// formatCompactValue renders a value on a single line, or <missing> for an invalid value.
+func formatCompactValue(val reflect.Value) string {
+	if !val.IsValid() {
+		return "<missing>"
+	}
+	if val.Type() == timeType && val.CanInterface() {
+		return formatTime(val.Interface().(time.Time))
+	}
+	if val.CanInterface() {
+		return compactValueConfig.Sprint(val.Interface())
+	}
+	return fmt.Sprintf("%v", val)
+}
+
+// renderPaths describes each difference on a single line, prefixed with its path.
+func renderPaths(diffs []difference) string {
+	var lines []string
+	for _, d := range diffs {
+		path := d.path
+		if path == "" {
+			path = "(root)"
+		}
+		expected, got := formatCompactValue(d.expected), formatCompactValue(d.got)
+		if d.expected.IsValid() && d.got.IsValid() && d.expected.Type() != d.got.Type() {
+			expected = fmt.Sprintf("%v(%s)", d.expected.Type(), expected)
+			got = fmt.Sprintf("%v(%s)", d.got.Type(), got)
+		}
+		lines = append(lines, fmt.Sprintf("%s: expected %s, got %s", path, expected, got))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderDifferences describes each difference with its path, followed by the expected and got values.
+func renderDifferences(diffs []difference) string {
+	var blocks []string
+	for _, d := range diffs {
+		path := d.path
+		if path == "" {
+			path = "(root)"
+		}
+		lines := []string{fmt.Sprintf("at %s:", path)}
+		if d.expected.IsValid() && d.got.IsValid() && d.expected.Type() != d.got.Type() {
+			lines[0] = fmt.Sprintf("at %s: expected type %v, got type %v", path, d.expected.Type(), d.got.Type())
+		}
+		if d.expected.IsValid() {
+			lines = append(lines, prefixLines("-", formatValue(d.expected)))
+		}
+		if d.got.IsValid() {
+			lines = append(lines, prefixLines("+", formatValue(d.got)))
+		}
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+	return strings.Join(blocks, "\n")
+}
+
+func prefixLines(prefix, s string) string {
+	return prefix + strings.Replace(s, "\n", "\n"+prefix, -1)
+}
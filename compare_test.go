@@ -0,0 +1,278 @@
+package must
+
+import (
+	"strings"
+	"testing"
+)
+
+type compareOrder struct {
+	ID    int
+	Items map[string]compareItem
+	Tags  []string
+	notes string
+}
+
+type compareItem struct {
+	Price float64
+	Meta  *compareMeta
+}
+
+type compareMeta struct {
+	Updated string
+}
+
+func TestBeEqualWithOptions(t *testing.T) {
+	var tests = []struct {
+		name       string
+		options    CompareOptions
+		expected   interface{}
+		got        interface{}
+		shouldPass bool
+	}{
+		{
+			name:       "no options, different",
+			expected:   compareOrder{ID: 1, Tags: []string{"a", "b"}},
+			got:        compareOrder{ID: 1, Tags: []string{"b", "a"}},
+			shouldPass: false,
+		},
+		{
+			name:       "unordered slices",
+			options:    CompareOptions{UnorderedSlices: true},
+			expected:   compareOrder{ID: 1, Tags: []string{"a", "b", "a"}},
+			got:        compareOrder{ID: 1, Tags: []string{"b", "a", "a"}},
+			shouldPass: true,
+		},
+		{
+			name:       "unordered slices, different counts",
+			options:    CompareOptions{UnorderedSlices: true},
+			expected:   []string{"a", "b", "a"},
+			got:        []string{"b", "a", "b"},
+			shouldPass: false,
+		},
+		{
+			name:       "ignore unexported",
+			options:    CompareOptions{IgnoreUnexported: true},
+			expected:   compareOrder{ID: 1, notes: "one"},
+			got:        compareOrder{ID: 1, notes: "two"},
+			shouldPass: true,
+		},
+		{
+			name:       "unexported compared by default",
+			options:    CompareOptions{NilEqualsEmpty: true},
+			expected:   compareOrder{ID: 1, notes: "one"},
+			got:        compareOrder{ID: 1, notes: "two"},
+			shouldPass: false,
+		},
+		{
+			name:    "ignore fields",
+			options: CompareOptions{IgnoreFields: []string{".Items[*].Meta.Updated", ".ID"}},
+			expected: compareOrder{ID: 1, Items: map[string]compareItem{
+				"sku": {Price: 10, Meta: &compareMeta{Updated: "yesterday"}},
+			}},
+			got: compareOrder{ID: 2, Items: map[string]compareItem{
+				"sku": {Price: 10, Meta: &compareMeta{Updated: "today"}},
+			}},
+			shouldPass: true,
+		},
+		{
+			name:       "ignore fields by exact path",
+			options:    CompareOptions{IgnoreFields: []string{`.Items["sku"].Price`}},
+			expected:   compareOrder{Items: map[string]compareItem{"sku": {Price: 10}, "other": {Price: 1}}},
+			got:        compareOrder{Items: map[string]compareItem{"sku": {Price: 12}, "other": {Price: 1}}},
+			shouldPass: true,
+		},
+		{
+			name:       "nil equals empty",
+			options:    CompareOptions{NilEqualsEmpty: true},
+			expected:   compareOrder{Tags: nil, Items: map[string]compareItem{}},
+			got:        compareOrder{Tags: []string{}, Items: nil},
+			shouldPass: true,
+		},
+		{
+			name:       "nil not equal to empty",
+			options:    CompareOptions{UnorderedSlices: true},
+			expected:   compareOrder{Tags: nil},
+			got:        compareOrder{Tags: []string{}},
+			shouldPass: false,
+		},
+		{
+			name:       "float within tolerance",
+			options:    CompareOptions{FloatTolerance: 0.01},
+			expected:   []float64{1.0, 2.0},
+			got:        []float64{1.005, 1.995},
+			shouldPass: true,
+		},
+		{
+			name:       "float outside tolerance",
+			options:    CompareOptions{FloatTolerance: 0.01},
+			expected:   []float64{1.0, 2.0},
+			got:        []float64{1.005, 1.9},
+			shouldPass: false,
+		},
+		{
+			name:       "different types",
+			options:    CompareOptions{FloatTolerance: 0.01},
+			expected:   1,
+			got:        "1",
+			shouldPass: false,
+		},
+		{
+			name:       "different float types within tolerance",
+			options:    CompareOptions{FloatTolerance: 0.01},
+			expected:   1.0,
+			got:        float32(1.001),
+			shouldPass: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T:       m,
+				Options: test.options,
+			}
+			result := tester.BeEqual(test.expected, test.got)
+			if result != test.shouldPass {
+				t.Errorf("result not as expected: expected %v, got %v", test.shouldPass, result)
+			}
+			if !result && m.format != "diff\n%s" {
+				t.Errorf("Incorrect error format, got '%v'", m.format)
+			}
+		})
+	}
+}
+
+func TestDiffWithOptions(t *testing.T) {
+	tester := Tester{
+		Options: CompareOptions{IgnoreFields: []string{".ID"}},
+		Color:   ColorNever,
+	}
+	expected := compareOrder{ID: 1, Items: map[string]compareItem{"sku": {Price: 10}}, Tags: []string{"a"}}
+	got := compareOrder{ID: 2, Items: map[string]compareItem{"sku": {Price: 12}}, Tags: []string{"a", "b"}}
+
+	out := tester.diff(expected, got)
+	for _, want := range []string{
+		"at .Items[\"sku\"].Price:\n-10\n+12",
+		"at .Tags[1]:\n+\"b\"",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected diff to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, ".ID") {
+		t.Errorf("Expected ignored field to be excluded from diff, got:\n%s", out)
+	}
+}
+
+func TestOptionsOnlyLoosenComparison(t *testing.T) {
+	type handler struct {
+		Name     string
+		Callback func() int
+	}
+	type hidden struct {
+		callback func() int
+	}
+	newCallback := func(n int) func() int {
+		return func() int { return n }
+	}
+	var tests = []struct {
+		name     string
+		expected interface{}
+		got      interface{}
+		equal    bool
+	}{
+		{
+			name:     "numbers of different types",
+			expected: 1,
+			got:      int64(1),
+			equal:    true,
+		},
+		{
+			name:     "different numbers of different types",
+			expected: 1,
+			got:      int64(2),
+			equal:    false,
+		},
+		{
+			name:     "nested numbers of different types",
+			expected: map[string]interface{}{"count": 1},
+			got:      map[string]interface{}{"count": uint8(1)},
+			equal:    true,
+		},
+		{
+			name:     "closures from the same function",
+			expected: handler{Name: "a", Callback: newCallback(1)},
+			got:      handler{Name: "a", Callback: newCallback(2)},
+			equal:    true,
+		},
+		{
+			name:     "unexported funcs",
+			expected: hidden{callback: func() int { return 1 }},
+			got:      hidden{callback: func() int { return 2 }},
+			equal:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := (Tester{}).equal(test.expected, test.got); got != test.equal {
+				t.Errorf("default comparison: expected %v, got %v", test.equal, got)
+			}
+			tester := Tester{Options: CompareOptions{FloatTolerance: 0.01}}
+			if got := tester.equal(test.expected, test.got); got != test.equal {
+				t.Errorf("comparison with options: expected %v, got %v", test.equal, got)
+			}
+		})
+	}
+}
+
+func TestMatchElements(t *testing.T) {
+	expected := []int{1, 2, 2, 3}
+	got := []int{2, 4, 1}
+	unmatchedExpected, unmatchedGot := matchElements(len(expected), len(got), func(i, j int) bool {
+		return expected[i] == got[j]
+	})
+	if len(unmatchedExpected) != 2 || unmatchedExpected[0] != 2 || unmatchedExpected[1] != 3 {
+		t.Errorf("Incorrect unmatched expected indices: %v", unmatchedExpected)
+	}
+	if len(unmatchedGot) != 1 || unmatchedGot[0] != 1 {
+		t.Errorf("Incorrect unmatched got indices: %v", unmatchedGot)
+	}
+}
+
+func TestDiffPaths(t *testing.T) {
+	type order struct {
+		Items map[string]compareItem
+	}
+	tester := Tester{
+		DiffStyle: DiffPaths,
+	}
+	expected := struct {
+		Orders []order
+		Count  interface{}
+	}{
+		Orders: []order{{}, {Items: map[string]compareItem{"sku": {Price: 10}, "old": {}}}},
+		Count:  1,
+	}
+	got := expected
+	got.Orders = []order{{}, {Items: map[string]compareItem{"sku": {Price: 12}}}, {}}
+	got.Count = int64(2)
+
+	out := tester.diff(expected, got)
+	want := `.Orders[1].Items["old"]: expected {Price:0,Meta:nil}, got <missing>` + "\n" +
+		`.Orders[1].Items["sku"].Price: expected 10, got 12` + "\n" +
+		`.Orders[2]: expected <missing>, got {Items:{}}` + "\n" +
+		`.Count: expected int(1), got int64(2)`
+	if out != want {
+		t.Errorf("Incorrect diff. Expected:\n%s\ngot:\n%s", want, out)
+	}
+}
+
+func TestDiffPathsFallback(t *testing.T) {
+	tester := Tester{
+		DiffStyle: DiffPaths,
+		Color:     ColorNever,
+	}
+	if out := tester.diff(1, 1); !strings.HasPrefix(out, "(- expected, + got)") {
+		t.Errorf("Expected fallback to pretty diff, got:\n%s", out)
+	}
+}
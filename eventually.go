@@ -0,0 +1,158 @@
+package must
+
+import (
+	"strings"
+	"time"
+)
+
+/*
+Eventually calls condition every interval until it returns true, triggering an error on t if it has not done so within timeout.
+
+The return value will be true if condition returned true within timeout.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func Eventually(t TestingT, condition func() bool, timeout, interval time.Duration, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.Eventually(condition, timeout, interval, a...)
+}
+
+/*
+Consistently calls condition every interval for the given duration, triggering an error on t if it ever returns false.
+
+The return value will be true if condition returned true on every call.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func Consistently(t TestingT, condition func() bool, duration, interval time.Duration, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.Consistently(condition, duration, interval, a...)
+}
+
+/*
+EventuallyCheck calls check every interval until all checks made within it pass, triggering an error on t if they have not done so within timeout.
+Any check function may be used within check, such as BeEqual or BeNoError, called on the provided MustTester.
+
+Failures are recorded rather than reported for each attempt, with only the failures from the last attempt included in the error.
+
+The return value will be true if an attempt passed within timeout.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func EventuallyCheck(t TestingT, check func(MustTester), timeout, interval time.Duration, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.EventuallyCheck(check, timeout, interval, a...)
+}
+
+/*
+ConsistentlyCheck calls check every interval for the given duration, triggering an error on t if any checks made within it fail.
+
+The error will include the failures from the first failed attempt.
+
+The return value will be true if every attempt passed.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func ConsistentlyCheck(t TestingT, check func(MustTester), duration, interval time.Duration, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.ConsistentlyCheck(check, duration, interval, a...)
+}
+
+/*
+Eventually calls condition until it returns true, triggering an error on the Tester's T if it does not do so within timeout.
+
+This corresponds to the function Eventually
+*/
+func (tester Tester) Eventually(condition func() bool, timeout, interval time.Duration, a ...interface{}) bool {
+	tester.T.Helper()
+	if poll(timeout, interval, condition) {
+		return true
+	}
+	tester.formattedError("condition was not met within %v", a, timeout)
+	return false
+}
+
+/*
+Consistently calls condition for the given duration, triggering an error on the Tester's T if it ever returns false.
+
+This corresponds to the function Consistently
+*/
+func (tester Tester) Consistently(condition func() bool, duration, interval time.Duration, a ...interface{}) bool {
+	tester.T.Helper()
+	start := time.Now()
+	if !poll(duration, interval, func() bool { return !condition() }) {
+		return true
+	}
+	tester.formattedError("condition stopped being met after %v", a, time.Since(start).Round(time.Millisecond))
+	return false
+}
+
+/*
+EventuallyCheck calls check until all checks within it pass, triggering an error on the Tester's T if they do not do so within timeout.
+
+This corresponds to the function EventuallyCheck
+*/
+func (tester Tester) EventuallyCheck(check func(MustTester), timeout, interval time.Duration, a ...interface{}) bool {
+	tester.T.Helper()
+	var last *recorder
+	passed := poll(timeout, interval, func() bool {
+		last = &recorder{}
+		check(tester.recording(last))
+		return !last.failed()
+	})
+	if passed {
+		return true
+	}
+	tester.formattedError("check did not pass within %v, last attempt:\n%s", a, timeout, indent(strings.Join(last.messages(), "\n")))
+	return false
+}
+
+/*
+ConsistentlyCheck calls check for the given duration, triggering an error on the Tester's T if any checks within it fail.
+
+This corresponds to the function ConsistentlyCheck
+*/
+func (tester Tester) ConsistentlyCheck(check func(MustTester), duration, interval time.Duration, a ...interface{}) bool {
+	tester.T.Helper()
+	start := time.Now()
+	var last *recorder
+	failed := poll(duration, interval, func() bool {
+		last = &recorder{}
+		check(tester.recording(last))
+		return last.failed()
+	})
+	if !failed {
+		return true
+	}
+	tester.formattedError("check stopped passing after %v:\n%s", a, time.Since(start).Round(time.Millisecond), indent(strings.Join(last.messages(), "\n")))
+	return false
+}
+
+// minPollInterval is the shortest interval between attempts, so that a zero or negative interval does not busy-wait.
+const minPollInterval = time.Millisecond
+
+// poll calls attempt every interval until it returns true or duration has elapsed, reporting whether attempt returned true.
+// The final attempt is made once duration has elapsed. Intervals shorter than minPollInterval are raised to it.
+func poll(duration, interval time.Duration, attempt func() bool) bool {
+	if interval < minPollInterval {
+		interval = minPollInterval
+	}
+	deadline := time.Now().Add(duration)
+	for {
+		if attempt() {
+			return true
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return false
+		}
+		if interval < remaining {
+			remaining = interval
+		}
+		time.Sleep(remaining)
+	}
+}
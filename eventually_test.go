@@ -0,0 +1,133 @@
+package must
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countdown returns a condition that becomes true after it has been called n times.
+func countdown(n int32) func() bool {
+	var calls int32
+	return func() bool {
+		return atomic.AddInt32(&calls, 1) > n
+	}
+}
+
+func TestEventually(t *testing.T) {
+	m := &MockTesting{}
+	if !Eventually(m, countdown(3), time.Second, time.Millisecond) {
+		t.Error("Check did not pass as expected")
+	}
+	if Eventually(m, func() bool { return false }, 10*time.Millisecond, time.Millisecond, "message") {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "%v: condition was not met within %v" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+}
+
+func TestEventuallyNonPositiveInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		var calls int32
+		m := &MockTesting{}
+		Eventually(m, func() bool {
+			atomic.AddInt32(&calls, 1)
+			return false
+		}, 20*time.Millisecond, interval)
+		// At most one attempt per minPollInterval, plus the final attempt
+		if calls > 21 {
+			t.Errorf("Expected the interval %v to be raised to %v, got %d calls", interval, minPollInterval, calls)
+		}
+	}
+}
+
+func TestConsistently(t *testing.T) {
+	m := &MockTesting{}
+	if !Consistently(m, func() bool { return true }, 10*time.Millisecond, time.Millisecond) {
+		t.Error("Check did not pass as expected")
+	}
+	if m.errorCalled {
+		t.Error("Error was raised for a consistent condition")
+	}
+	stopsAfterThree := countdown(3)
+	if Consistently(m, func() bool { return !stopsAfterThree() }, time.Second, time.Millisecond) {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "condition stopped being met after %v" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+}
+
+func TestEventuallyCheck(t *testing.T) {
+	m := &MockTesting{}
+	var attempts int
+	passed := EventuallyCheck(m, func(mt MustTester) {
+		attempts++
+		mt.BeEqual(3, attempts)
+	}, time.Second, time.Millisecond)
+	if !passed {
+		t.Error("Check did not pass as expected")
+	}
+	if m.errorCalled {
+		t.Errorf("Error was raised for failed attempts: %v", m.args)
+	}
+
+	attempts = 0
+	passed = EventuallyCheck(m, func(mt MustTester) {
+		attempts++
+		mt.BeEqual("expected", "got", fmt.Sprintf("attempt %d", attempts))
+	}, 10*time.Millisecond, time.Millisecond)
+	if passed {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "check did not pass within %v, last attempt:\n%s" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+	if len(m.args) != 2 {
+		t.Fatalf("Expected 2 error args, got %v", m.args)
+	}
+	lastAttempt := fmt.Sprintf("attempt %d: diff", attempts)
+	if report, _ := m.args[1].(string); !strings.Contains(report, lastAttempt) {
+		t.Errorf("Expected report of last attempt containing %q, got:\n%v", lastAttempt, report)
+	}
+}
+
+func TestConsistentlyCheck(t *testing.T) {
+	m := &MockFatalTesting{}
+	tester := Tester{
+		T:     m,
+		Fatal: true,
+	}
+	var attempts int
+	passed := tester.ConsistentlyCheck(func(mt MustTester) {
+		attempts++
+		mt.BeSameLength("abc", "def")
+	}, 10*time.Millisecond, time.Millisecond)
+	if !passed || m.errorCalled || m.failNowCalled {
+		t.Error("Check did not pass as expected")
+	}
+
+	attempts = 0
+	passed = tester.ConsistentlyCheck(func(mt MustTester) {
+		attempts++
+		mt.BeNoError(nil)
+		if attempts > 2 {
+			mt.BeError(nil)
+		}
+	}, time.Second, time.Millisecond)
+	if passed {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "check stopped passing after %v:\n%s" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected check to stop after 3 attempts, got %d", attempts)
+	}
+	if !m.failNowCalled {
+		t.Error("FailNow was not called for a Fatal Tester")
+	}
+}
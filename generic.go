@@ -0,0 +1,27 @@
+package must
+
+/*
+Equal compares the expected and got values, triggering an error on t if they are not equal.
+Unlike BeEqual, expected and got must be of the same type, so mismatched comparisons (such as an int against an int64) are caught at compile time.
+
+The return value will be true if the values are equal.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func Equal[T any](t TestingT, expected, got T, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeEqual(expected, got, a...)
+}
+
+/*
+EqualWith compares the expected and got values using the provided Tester, which must be of the same type.
+
+This allows type-safe comparisons that still make use of a Tester's InterfaceComparison and InterfaceDiff functions.
+
+The return value will be true if the values are equal.
+*/
+func EqualWith[T any](tester Tester, expected, got T, a ...interface{}) bool {
+	tester.T.Helper()
+	return tester.BeEqual(expected, got, a...)
+}
@@ -0,0 +1,46 @@
+package must
+
+import "testing"
+
+func TestEqual(t *testing.T) {
+	m := &MockTesting{}
+	if !Equal(m, []int{1, 2}, []int{1, 2}) {
+		t.Error("Check did not pass as expected")
+	}
+	if m.errorCalled {
+		t.Error("Error was raised for matching values")
+	}
+
+	if Equal(m, 1, 2, "message") {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "%v: diff\n%s" {
+		t.Errorf("Incorrect error format. Expected '%v', got '%v'", "%v: diff\n%s", m.format)
+	}
+}
+
+func TestEqualWith(t *testing.T) {
+	m := &MockTesting{}
+	tester := Tester{
+		T: m,
+		InterfaceComparison: func(expected, got interface{}) bool {
+			return true
+		},
+	}
+	if !EqualWith(tester, "a", "b") {
+		t.Error("Custom comparison was not used")
+	}
+
+	tester = Tester{
+		T: m,
+		InterfaceDiff: func(expected, got interface{}) string {
+			return "forced diff"
+		},
+	}
+	if EqualWith(tester, "a", "b", "message") {
+		t.Error("Check did not fail as expected")
+	}
+	if len(m.args) < 2 || m.args[1] != "forced diff" {
+		t.Errorf("Custom diff func was not used, got '%v'", m.args)
+	}
+}
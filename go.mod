@@ -0,0 +1,5 @@
+module github.com/theothertomelliott/must
+
+go 1.18
+
+require github.com/kylelemons/godebug v1.1.0
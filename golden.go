@@ -0,0 +1,118 @@
+package must
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// goldenDir is the directory, relative to the package under test, in which golden files are stored.
+var goldenDir = "testdata"
+
+/*
+UpdateGolden causes BeEqualGolden and BeEqualGoldenValue to write golden files with the values they are given, rather than comparing against them.
+
+Golden files are also updated when the test package defines its own boolean -update flag and it is set. To use the -update flag
+without declaring one in a test package, register it with this variable:
+
+	func init() {
+		flag.BoolVar(&must.UpdateGolden, "update", false, "update golden files")
+	}
+*/
+var UpdateGolden bool
+
+// updateGolden reports whether golden files should be written, from UpdateGolden or an -update flag defined by the test package.
+func updateGolden() bool {
+	if UpdateGolden {
+		return true
+	}
+	f := flag.Lookup("update")
+	if f == nil {
+		return false
+	}
+	update, _ := strconv.ParseBool(f.Value.String())
+	return update
+}
+
+/*
+BeEqualGolden compares got against the content of the golden file testdata/<TestName>/<name>.golden, triggering an error on t if they do not match.
+This error will include a diff of the file content and got.
+
+When UpdateGolden is set, or the test binary is run with an -update flag defined by the test package, the golden file will be written with got instead, and the check will pass.
+
+The return value will be true if got matches the golden file.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeEqualGolden(t TestingT, name, got string, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeEqualGolden(name, got, a...)
+}
+
+/*
+BeEqualGoldenValue serializes got deterministically, with map keys sorted, and compares the result against a golden file, as with BeEqualGolden.
+
+The return value will be true if the serialized value matches the golden file.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeEqualGoldenValue(t TestingT, name string, got interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeEqualGoldenValue(name, got, a...)
+}
+
+/*
+BeEqualGolden compares got against the content of a golden file, triggering an error on the Tester's T if they do not match.
+
+This corresponds to the function BeEqualGolden
+*/
+func (tester Tester) BeEqualGolden(name, got string, a ...interface{}) bool {
+	tester.T.Helper()
+	path := tester.goldenPath(name)
+	if updateGolden() {
+		if err := writeGolden(path, got); err != nil {
+			tester.formattedError("could not update golden file %s - %v", a, path, err)
+			return false
+		}
+		return true
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		tester.formattedError("could not read golden file %s, run with -update to create it - %v", a, path, err)
+		return false
+	}
+	if string(expected) == got {
+		return true
+	}
+	tester.formattedError("golden file %s does not match\n%s", a, path, tester.diff(string(expected), got))
+	return false
+}
+
+/*
+BeEqualGoldenValue serializes got and compares it against the content of a golden file, triggering an error on the Tester's T if they do not match.
+
+This corresponds to the function BeEqualGoldenValue
+*/
+func (tester Tester) BeEqualGoldenValue(name string, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	return tester.BeEqualGolden(name, valueConfig.Sprint(got), a...)
+}
+
+// goldenPath returns the path to the named golden file for the current test, if T provides a test name.
+func (tester Tester) goldenPath(name string) string {
+	if named, ok := tester.T.(interface{ Name() string }); ok {
+		return filepath.Join(goldenDir, filepath.FromSlash(named.Name()), name+".golden")
+	}
+	return filepath.Join(goldenDir, name+".golden")
+}
+
+func writeGolden(path, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(content), 0644)
+}
@@ -0,0 +1,46 @@
+package must_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/theothertomelliott/must"
+)
+
+// updateFlag is declared as in a typical golden file test package, which is initialized after the must package.
+// Registering -update in must would cause this declaration to panic when the test binary starts.
+var updateFlag = flag.Bool("update", false, "update golden files")
+
+func TestBeEqualGoldenWithCallerUpdateFlag(t *testing.T) {
+	if err := flag.Set("update", "true"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		*updateFlag = false
+	})
+
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(wd)
+	})
+
+	if !must.BeEqualGolden(t, "output", "from flag") {
+		t.Error("Check did not pass when the caller's -update flag was set")
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "testdata", t.Name(), "output.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "from flag" {
+		t.Errorf("Golden file was not updated, got %q", content)
+	}
+}
@@ -0,0 +1,97 @@
+package must
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type mockNamedTesting struct {
+	MockTesting
+	name string
+}
+
+func (m *mockNamedTesting) Name() string {
+	return m.name
+}
+
+func useGoldenDir(t *testing.T, updateFiles bool) string {
+	dir := t.TempDir()
+	previousDir, previousUpdate := goldenDir, UpdateGolden
+	goldenDir, UpdateGolden = dir, updateFiles
+	t.Cleanup(func() {
+		goldenDir, UpdateGolden = previousDir, previousUpdate
+	})
+	return dir
+}
+
+func TestBeEqualGolden(t *testing.T) {
+	var tests = []struct {
+		name       string
+		golden     *string
+		got        string
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "matching",
+			golden:     stringToPointer("line1\nline2\n"),
+			got:        "line1\nline2\n",
+			shouldPass: true,
+		},
+		{
+			name:   "not matching",
+			golden: stringToPointer("line1\nline2\n"),
+			got:    "line1\nline3\n",
+			format: "golden file %s does not match\n%s",
+		},
+		{
+			name:   "missing file",
+			got:    "line1\n",
+			format: "could not read golden file %s, run with -update to create it - %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := useGoldenDir(t, false)
+			if test.golden != nil {
+				if err := writeGolden(filepath.Join(dir, "TestSample", "output.golden"), *test.golden); err != nil {
+					t.Fatal(err)
+				}
+			}
+			m := &mockNamedTesting{name: "TestSample"}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeEqualGolden("output", test.got)
+			checkResults(t, test.shouldPass, result, test.format, &m.MockTesting)
+		})
+	}
+}
+
+func TestBeEqualGoldenUpdate(t *testing.T) {
+	dir := useGoldenDir(t, true)
+	m := &mockNamedTesting{name: "TestSample/sub_test"}
+	if !BeEqualGolden(m, "output", "updated") {
+		t.Error("Check did not pass in update mode")
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "TestSample", "sub_test", "output.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "updated" {
+		t.Errorf("Golden file was not updated, got %q", content)
+	}
+}
+
+func TestBeEqualGoldenValue(t *testing.T) {
+	dir := useGoldenDir(t, false)
+	value := map[string]int{"b": 2, "a": 1}
+	if err := writeGolden(filepath.Join(dir, "values.golden"), "{\n a: 1,\n b: 2,\n}"); err != nil {
+		t.Fatal(err)
+	}
+	m := &MockTesting{}
+	if !BeEqualGoldenValue(m, "values", value) {
+		t.Errorf("Check did not pass as expected: %v", m.args)
+	}
+}
@@ -0,0 +1,64 @@
+package must
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+Group runs check, collecting the failures of all checks made on the provided MustTester within it.
+Should any checks fail, a single error will be triggered on t, with a numbered report of every failure in the group.
+
+The return value will be true if every check in the group passed.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func Group(t TestingT, name string, check func(MustTester), a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.Group(name, check, a...)
+}
+
+/*
+All runs check, collecting the failures of all checks made within it into a single error on t, as with an unnamed Group.
+
+The return value will be true if every check passed.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func All(t TestingT, check func(MustTester), a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.Group("", check, a...)
+}
+
+/*
+Group runs check, collecting the failures of all checks within it into a single error on the Tester's T.
+
+This corresponds to the function Group
+*/
+func (tester Tester) Group(name string, check func(MustTester), a ...interface{}) bool {
+	tester.T.Helper()
+	rec := &recorder{}
+	check(tester.recording(rec))
+	if !rec.failed() {
+		return true
+	}
+
+	messages := rec.messages()
+	summary := fmt.Sprintf("%d checks failed", len(messages))
+	if len(messages) == 1 {
+		summary = "1 check failed"
+	}
+	if name != "" {
+		summary = fmt.Sprintf("group %q: %s", name, summary)
+	}
+	width := len(fmt.Sprint(len(messages)))
+	var report []string
+	for i, message := range messages {
+		numbered := fmt.Sprintf("%*d) %s", width, i+1, message)
+		report = append(report, strings.Replace(numbered, "\n", "\n"+strings.Repeat(" ", width+2), -1))
+	}
+	tester.formattedError("%s\n%s", a, summary, strings.Join(report, "\n"))
+	return false
+}
@@ -0,0 +1,108 @@
+package must
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGroup(t *testing.T) {
+	var tests = []struct {
+		name       string
+		group      string
+		check      func(MustTester)
+		shouldPass bool
+		report     string
+	}{
+		{
+			name:  "all checks pass",
+			group: "fields",
+			check: func(mt MustTester) {
+				mt.BeEqual(1, 1)
+				mt.BeNoError(nil)
+			},
+			shouldPass: true,
+		},
+		{
+			name:  "one check fails",
+			group: "fields",
+			check: func(mt MustTester) {
+				mt.BeEqual(1, 1)
+				mt.BeNoError(errors.New("failed"), "name")
+			},
+			report: "group \"fields\": 1 check failed\n1) name: error: failed",
+		},
+		{
+			name: "multiple checks fail",
+			check: func(mt MustTester) {
+				mt.BeSameLength("a", "bc")
+				mt.BeError(nil)
+				mt.BeErrorContaining("x", errors.New("line1\nline2"))
+			},
+			report: "3 checks failed\n" +
+				"1) expected length 1, got length 2\n" +
+				"2) expected an error, but got nil\n" +
+				"3) expected error message to contain \"x\", got:\n" +
+				"   1 | line1\n" +
+				"   2 | line2",
+		},
+		{
+			name:  "nested groups",
+			group: "outer",
+			check: func(mt MustTester) {
+				mt.Group("inner", func(mt MustTester) {
+					mt.BeError(nil)
+				})
+				mt.BeError(nil)
+			},
+			report: "group \"outer\": 2 checks failed\n" +
+				"1) group \"inner\": 1 check failed\n" +
+				"   1) expected an error, but got nil\n" +
+				"2) expected an error, but got nil",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockFatalTesting{}
+			tester := Tester{
+				T:     m,
+				Fatal: true,
+			}
+			result := tester.Group(test.group, test.check)
+			if result != test.shouldPass {
+				t.Errorf("result not as expected: expected %v, got %v", test.shouldPass, result)
+			}
+			if m.failNowCalled == test.shouldPass {
+				t.Errorf("failNowCalled not as expected: got %v", m.failNowCalled)
+			}
+			if test.shouldPass {
+				if m.errorCalled {
+					t.Errorf("Error was raised for a passing group: %v", m.args)
+				}
+				return
+			}
+			if m.format != "%s\n%s" || len(m.args) != 2 {
+				t.Fatalf("Incorrect error, got format '%v' with args %v", m.format, m.args)
+			}
+			if report := m.args[0].(string) + "\n" + m.args[1].(string); report != test.report {
+				t.Errorf("Incorrect report. Expected:\n%s\ngot:\n%s", test.report, report)
+			}
+		})
+	}
+}
+
+func TestAll(t *testing.T) {
+	m := &MockTesting{}
+	result := All(m, func(mt MustTester) {
+		mt.BeEqual(1, 2)
+		mt.BeEqual(3, 4)
+	}, "message")
+	if result {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "%v: %s\n%s" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+	if len(m.args) != 3 || m.args[1] != "2 checks failed" {
+		t.Errorf("Incorrect error args, got %v", m.args)
+	}
+}
@@ -0,0 +1,152 @@
+package must
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	markedMaxLines = 20 // Number of lines above which markedText only shows the lines around the mark
+	markedContext  = 3  // Number of lines shown either side of the mark, when not all lines are shown
+)
+
+/*
+markedText renders s with numbered lines, marking the bytes s[start:end] with carets on the line below each line they cover.
+
+A negative start renders s without any marks. Should s have more than markedMaxLines lines, only the lines around the mark are shown,
+or the first markedMaxLines lines if there is no mark.
+*/
+func markedText(s string, start, end int) string {
+	lines := strings.Split(s, "\n")
+	carets := make([]string, len(lines))
+	firstMarked, lastMarked := -1, -1
+	offset := 0
+	for i, line := range lines {
+		lineEnd := offset + len(line)
+		if start >= 0 && start <= lineEnd && end > offset {
+			from, to := start-offset, end-offset
+			if from < 0 {
+				from = 0
+			}
+			if to > len(line) {
+				to = len(line)
+			}
+			if to > from {
+				carets[i] = padding(line[:from]) + strings.Repeat("^", utf8.RuneCountInString(line[from:to]))
+				if firstMarked < 0 {
+					firstMarked = i
+				}
+				lastMarked = i
+			}
+		}
+		offset = lineEnd + 1
+	}
+
+	first, last := 0, len(lines)
+	if len(lines) > markedMaxLines {
+		if firstMarked >= 0 {
+			first, last = firstMarked-markedContext, lastMarked+markedContext+1
+			if first < 0 {
+				first = 0
+			}
+			if last > len(lines) {
+				last = len(lines)
+			}
+		} else {
+			last = markedMaxLines
+		}
+	}
+
+	width := len(fmt.Sprint(len(lines)))
+	var out []string
+	if first > 0 {
+		out = append(out, fmt.Sprintf("%*s | ... (%d lines)", width, "", first))
+	}
+	for i := first; i < last; i++ {
+		out = append(out, fmt.Sprintf("%*d | %s", width, i+1, lines[i]))
+		if carets[i] != "" {
+			out = append(out, fmt.Sprintf("%*s | %s", width, "", carets[i]))
+		}
+	}
+	if last < len(lines) {
+		out = append(out, fmt.Sprintf("%*s | ... (%d more lines)", width, "", len(lines)-last))
+	}
+	return strings.Join(out, "\n")
+}
+
+// padding returns whitespace occupying the same columns as prefix, preserving tabs.
+func padding(prefix string) string {
+	var b strings.Builder
+	for _, r := range prefix {
+		if r == '\t' {
+			b.WriteRune('\t')
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	return b.String()
+}
+
+/*
+partialMatch finds the longest prefix of substr that appears in s, returning its position.
+
+If not even the first character of substr appears in s, start will be -1.
+*/
+func partialMatch(s, substr string) (start, end int) {
+	for n := len(substr); n > 0; n-- {
+		if !utf8.ValidString(substr[:n]) {
+			continue
+		}
+		if i := strings.Index(s, substr[:n]); i >= 0 {
+			return i, i + n
+		}
+	}
+	return -1, -1
+}
+
+/*
+partialRegexpMatch finds the longest prefix of pattern that is a valid expression and matches a non-empty part of s, returning the position of that match.
+
+If no prefix of pattern matches, start will be -1.
+*/
+func partialRegexpMatch(s, pattern string) (start, end int) {
+	for n := len(pattern); n > 0; n-- {
+		if !utf8.ValidString(pattern[:n]) {
+			continue
+		}
+		re, err := regexp.Compile(pattern[:n])
+		if err != nil {
+			continue
+		}
+		if loc := re.FindStringIndex(s); loc != nil && loc[1] > loc[0] {
+			return loc[0], loc[1]
+		}
+	}
+	return -1, -1
+}
+
+// commonPrefixLength returns the length in bytes of the longest common prefix of a and b, ending on a rune boundary.
+func commonPrefixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	for n > 0 && n < len(a) && !utf8.RuneStart(a[n]) {
+		n--
+	}
+	return n
+}
+
+// commonSuffixLength returns the length in bytes of the longest common suffix of a and b, starting on a rune boundary.
+func commonSuffixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	for n > 0 && !utf8.RuneStart(a[len(a)-n]) {
+		n--
+	}
+	return n
+}
@@ -0,0 +1,117 @@
+package must
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMarkedText(t *testing.T) {
+	var tests = []struct {
+		name     string
+		s        string
+		start    int
+		end      int
+		expected string
+	}{
+		{
+			name:     "no mark",
+			s:        "line one",
+			start:    -1,
+			end:      -1,
+			expected: "1 | line one",
+		},
+		{
+			name:     "single line",
+			s:        "open config.yaml: denied",
+			start:    5,
+			end:      11,
+			expected: "1 | open config.yaml: denied\n  |      ^^^^^^",
+		},
+		{
+			name:     "second line",
+			s:        "first\n\tsecond",
+			start:    7,
+			end:      13,
+			expected: "1 | first\n2 | \tsecond\n  | \t^^^^^^",
+		},
+		{
+			name:     "spanning lines",
+			s:        "ab\ncd",
+			start:    1,
+			end:      4,
+			expected: "1 | ab\n  |  ^\n2 | cd\n  | ^",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := markedText(test.s, test.start, test.end); got != test.expected {
+				t.Errorf("Incorrect output. Expected:\n%s\ngot:\n%s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestPartialMatch(t *testing.T) {
+	start, end := partialMatch("open config.yaml", "config.json")
+	if start != 5 || end != 12 {
+		t.Errorf("Expected match at 5-12, got %d-%d", start, end)
+	}
+	start, _ = partialMatch("open config.yaml", "xyz")
+	if start != -1 {
+		t.Errorf("Expected no match, got %d", start)
+	}
+}
+
+func TestPartialRegexpMatch(t *testing.T) {
+	start, end := partialRegexpMatch("open config.yaml", `open \w+\.json`)
+	if start != 0 || end != 12 {
+		t.Errorf("Expected match at 0-12, got %d-%d", start, end)
+	}
+	start, _ = partialRegexpMatch("open config.yaml", `xyz`)
+	if start != -1 {
+		t.Errorf("Expected no match, got %d", start)
+	}
+}
+
+func TestMarkedTextLong(t *testing.T) {
+	var lines []string
+	for i := 1; i <= 30; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	s := strings.Join(lines, "\n")
+
+	start := strings.Index(s, "line 15")
+	expected := strings.Join([]string{
+		"   | ... (11 lines)",
+		"12 | line 12",
+		"13 | line 13",
+		"14 | line 14",
+		"15 | line 15",
+		"   | ^^^^^^^",
+		"16 | line 16",
+		"17 | line 17",
+		"18 | line 18",
+		"   | ... (12 more lines)",
+	}, "\n")
+	if got := markedText(s, start, start+7); got != expected {
+		t.Errorf("Incorrect output. Expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	got := markedText(s, -1, -1)
+	if !strings.HasSuffix(got, "20 | line 20\n   | ... (10 more lines)") {
+		t.Errorf("Expected output truncated after 20 lines, got:\n%s", got)
+	}
+}
+
+func TestCommonPrefixAndSuffix(t *testing.T) {
+	if n := commonPrefixLength("héllo", "hèllo"); n != 1 {
+		t.Errorf("Expected common prefix to end on a rune boundary, got %d", n)
+	}
+	if n := commonSuffixLength("file.yaml", "other.yaml"); n != 5 {
+		t.Errorf("Expected common suffix of 5, got %d", n)
+	}
+	if n := commonSuffixLength("aé", "bè"); n != 0 {
+		t.Errorf("Expected common suffix to start on a rune boundary, got %d", n)
+	}
+}
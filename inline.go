@@ -0,0 +1,161 @@
+package must
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	inlineMaxCells = 1 << 20 // Largest comparison table used by inlineDiff before falling back to marking the whole changed middle
+	inlineMinEqual = 3       // Unchanged runs shorter than this many characters between two changes are absorbed into the change
+)
+
+// inlineDiffHeader is shown above an inline diff, describing the markers used.
+const inlineDiffHeader = "([-expected-], {+got+})"
+
+type editOp int
+
+const (
+	editEqual editOp = iota
+	editDelete
+	editInsert
+)
+
+// edit is a run of characters that are unchanged, removed from expected or added in got.
+type edit struct {
+	op   editOp
+	text []rune
+}
+
+/*
+inlineDiff renders the character-level differences between expected and got on a single line,
+wrapping removed runs in [-...-] and added runs in {+...+}, highlighted by style.
+*/
+func inlineDiff(expected, got string, style styler) string {
+	edits := runeEdits([]rune(expected), []rune(got))
+
+	var out, removed, added strings.Builder
+	flush := func() {
+		if removed.Len() > 0 {
+			out.WriteString(style.removedInline("[-" + removed.String() + "-]"))
+		}
+		if added.Len() > 0 {
+			out.WriteString(style.addedInline("{+" + added.String() + "+}"))
+		}
+		removed.Reset()
+		added.Reset()
+	}
+	for i, e := range edits {
+		switch e.op {
+		case editDelete:
+			removed.WriteString(string(e.text))
+		case editInsert:
+			added.WriteString(string(e.text))
+		case editEqual:
+			// Short runs between two changes make the output harder to read than a single larger change
+			if i > 0 && i < len(edits)-1 && len(e.text) < inlineMinEqual {
+				removed.WriteString(string(e.text))
+				added.WriteString(string(e.text))
+				continue
+			}
+			flush()
+			out.WriteString(string(e.text))
+		}
+	}
+	flush()
+	return out.String()
+}
+
+// runeEdits returns the edits that transform a into b, with consecutive edits of the same kind combined.
+func runeEdits(a, b []rune) []edit {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var edits []edit
+	add := func(op editOp, r ...rune) {
+		if len(r) == 0 {
+			return
+		}
+		if n := len(edits); n > 0 && edits[n-1].op == op {
+			edits[n-1].text = append(edits[n-1].text, r...)
+			return
+		}
+		edits = append(edits, edit{op: op, text: append([]rune(nil), r...)})
+	}
+
+	add(editEqual, a[:prefix]...)
+	middleA, middleB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(middleA)*len(middleB) > inlineMaxCells {
+		add(editDelete, middleA...)
+		add(editInsert, middleB...)
+	} else {
+		for _, e := range lcsEdits(middleA, middleB) {
+			add(e.op, e.text...)
+		}
+	}
+	add(editEqual, a[len(a)-suffix:]...)
+	return edits
+}
+
+// lcsEdits returns single character edits transforming a into b, based on their longest common subsequence.
+func lcsEdits(a, b []rune) []edit {
+	// lengths[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var edits []edit
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, edit{op: editEqual, text: a[i : i+1]})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lengths[i+1][j] >= lengths[i][j+1]):
+			edits = append(edits, edit{op: editDelete, text: a[i : i+1]})
+			i++
+		default:
+			edits = append(edits, edit{op: editInsert, text: b[j : j+1]})
+			j++
+		}
+	}
+	return edits
+}
+
+// singleLine reports whether s contains no line breaks.
+func singleLine(s string) bool {
+	return !strings.ContainsAny(s, "\r\n")
+}
+
+// textBytes reports whether b holds printable UTF-8 text, and so can be diffed as a string.
+func textBytes(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
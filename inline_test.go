@@ -0,0 +1,117 @@
+package must
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInlineDiff(t *testing.T) {
+	var tests = []struct {
+		name     string
+		expected string
+		got      string
+		out      string
+	}{
+		{
+			name:     "equal",
+			expected: "abc",
+			got:      "abc",
+			out:      "abc",
+		},
+		{
+			name:     "single character",
+			expected: "https://example.com/users/123?sort=asc",
+			got:      "https://example.com/users/124?sort=asc",
+			out:      "https://example.com/users/12[-3-]{+4+}?sort=asc",
+		},
+		{
+			name:     "insertion",
+			expected: "token-abc",
+			got:      "token-xyz-abc",
+			out:      "token-{+xyz-+}abc",
+		},
+		{
+			name:     "deletion",
+			expected: "hello, world",
+			got:      "hello",
+			out:      "hello[-, world-]",
+		},
+		{
+			name:     "from empty",
+			expected: "",
+			got:      "new",
+			out:      "{+new+}",
+		},
+		{
+			name:     "separate changes",
+			expected: "the quick brown fox",
+			got:      "the quack brown box",
+			out:      "the qu[-i-]{+a+}ck brown [-f-]{+b+}ox",
+		},
+		{
+			name:     "short unchanged runs are absorbed",
+			expected: "cat",
+			got:      "bar",
+			out:      "[-cat-]{+bar+}",
+		},
+		{
+			name:     "multibyte characters",
+			expected: "naïve café",
+			got:      "naive café",
+			out:      "na[-ï-]{+i+}ve café",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if out := inlineDiff(test.expected, test.got, styler{}); out != test.out {
+				t.Errorf("expected %q, got %q", test.out, out)
+			}
+		})
+	}
+}
+
+func TestBeEqualInlineDiff(t *testing.T) {
+	var tests = []struct {
+		name     string
+		expected interface{}
+		got      interface{}
+		rendered string
+	}{
+		{
+			name:     "strings",
+			expected: "id=123",
+			got:      "id=124",
+			rendered: "([-expected-], {+got+})\nid=12[-3-]{+4+}",
+		},
+		{
+			name:     "byte slices",
+			expected: []byte("status: ok"),
+			got:      []byte("status: failed"),
+			rendered: "([-expected-], {+got+})\nstatus: [-ok-]{+failed+}",
+		},
+		{
+			name:     "whitespace only",
+			expected: "a b",
+			got:      "a\tb",
+			rendered: "([-expected-], {+got+})\na[-·-]{+→+}b",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{T: m, Color: ColorNever}
+			result := tester.BeEqual(test.expected, test.got)
+			checkResults(t, false, result, "diff\n%s", m)
+			if len(m.args) != 1 || m.args[0] != test.rendered {
+				t.Errorf("Incorrect output. Expected:\n%s\ngot:\n%v", test.rendered, m.args)
+			}
+		})
+	}
+
+	m := &MockTesting{}
+	tester := Tester{T: m, Color: ColorNever}
+	tester.BeEqual("line 1\nline 2", "line 1\nline 3")
+	if rendered := m.args[0].(string); !strings.HasPrefix(rendered, "(- expected, + got)") {
+		t.Errorf("expected a line diff for multiline strings, got:\n%s", rendered)
+	}
+}
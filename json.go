@@ -0,0 +1,174 @@
+package must
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+/*
+BeEqualJSON compares the JSON documents expected and got semantically, triggering an error on t if they are not equal.
+Both expected and got may be provided as a string, []byte or json.RawMessage.
+
+Object keys may appear in any order, whitespace is ignored and numbers are compared by value, so 1.0 and 1e0 are equal.
+Should the documents differ, the error will list each difference with its JSON pointer.
+
+The return value will be true if the documents are equal.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeEqualJSON(t TestingT, expected, got interface{}, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeEqualJSON(expected, got, a...)
+}
+
+/*
+BeEqualJSON compares the JSON documents expected and got semantically, triggering an error on the Tester's T if they are not equal.
+
+This corresponds to the function BeEqualJSON
+*/
+func (tester Tester) BeEqualJSON(expected, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	expectedDoc, err := parseJSON(expected)
+	if err != nil {
+		tester.formattedError("could not parse expected JSON - %v", a, err)
+		return false
+	}
+	gotDoc, err := parseJSON(got)
+	if err != nil {
+		tester.formattedError("could not parse got JSON - %v", a, err)
+		return false
+	}
+
+	var diffs []string
+	compareJSON("", expectedDoc, gotDoc, &diffs)
+	if len(diffs) == 0 {
+		return true
+	}
+	tester.formattedError("JSON not equal\n%s", a, strings.Join(diffs, "\n"))
+	return false
+}
+
+func parseJSON(doc interface{}) (interface{}, error) {
+	var data []byte
+	switch d := doc.(type) {
+	case string:
+		data = []byte(d)
+	case []byte:
+		data = d
+	case json.RawMessage:
+		data = d
+	default:
+		return nil, fmt.Errorf("unsupported type %T, expected string, []byte or json.RawMessage", doc)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var out interface{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return out, nil
+}
+
+// compareJSON appends a description of each difference between two decoded JSON values to diffs, identified by its JSON pointer.
+func compareJSON(pointer string, expected, got interface{}, diffs *[]string) {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for _, key := range unionKeys(e, g) {
+			keyPointer := pointer + "/" + escapeJSONPointer(key)
+			ev, eok := e[key]
+			gv, gok := g[key]
+			switch {
+			case !gok:
+				*diffs = append(*diffs, formatJSONDiff(keyPointer, formatJSON(ev), "<missing>"))
+			case !eok:
+				*diffs = append(*diffs, formatJSONDiff(keyPointer, "<missing>", formatJSON(gv)))
+			default:
+				compareJSON(keyPointer, ev, gv, diffs)
+			}
+		}
+		return
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(e) || i < len(g); i++ {
+			indexPointer := fmt.Sprintf("%s/%d", pointer, i)
+			switch {
+			case i >= len(g):
+				*diffs = append(*diffs, formatJSONDiff(indexPointer, formatJSON(e[i]), "<missing>"))
+			case i >= len(e):
+				*diffs = append(*diffs, formatJSONDiff(indexPointer, "<missing>", formatJSON(g[i])))
+			default:
+				compareJSON(indexPointer, e[i], g[i], diffs)
+			}
+		}
+		return
+	case json.Number:
+		if g, ok := got.(json.Number); ok && equalJSONNumbers(e, g) {
+			return
+		}
+	default:
+		if expected == got {
+			return
+		}
+	}
+	*diffs = append(*diffs, formatJSONDiff(pointer, formatJSON(expected), formatJSON(got)))
+}
+
+func equalJSONNumbers(expected, got json.Number) bool {
+	e, eok := new(big.Rat).SetString(string(expected))
+	g, gok := new(big.Rat).SetString(string(got))
+	if !eok || !gok {
+		return expected == got
+	}
+	return e.Cmp(g) == 0
+}
+
+func unionKeys(expected, got map[string]interface{}) []string {
+	var keys []string
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	for key := range got {
+		if _, ok := expected[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// escapeJSONPointer escapes a reference token as described in RFC 6901.
+func escapeJSONPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+func formatJSONDiff(pointer, expected, got string) string {
+	if pointer == "" {
+		pointer = "(root)"
+	}
+	return fmt.Sprintf("%s: expected %s, got %s", pointer, expected, got)
+}
+
+func formatJSON(val interface{}) string {
+	out, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprint(val)
+	}
+	return string(out)
+}
@@ -0,0 +1,77 @@
+package must
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBeEqualJSON(t *testing.T) {
+	var tests = []struct {
+		name       string
+		expected   interface{}
+		got        interface{}
+		shouldPass bool
+		format     string
+		diff       string
+	}{
+		{
+			name:       "different key order and whitespace",
+			expected:   `{"a": 1, "b": [true, null]}`,
+			got:        []byte(`{"b":[true,null],"a":1}`),
+			shouldPass: true,
+		},
+		{
+			name:       "normalized numbers",
+			expected:   json.RawMessage(`{"price": 1.50, "count": 1e2}`),
+			got:        `{"price": 1.5, "count": 100}`,
+			shouldPass: true,
+		},
+		{
+			name:     "different values",
+			expected: `{"orders": [{"price": 10}, {"price": 11}], "a/b": "x"}`,
+			got:      `{"orders": [{"price": 10}, {"price": 12, "extra": true}]}`,
+			format:   "JSON not equal\n%s",
+			diff: `/a~1b: expected "x", got <missing>` + "\n" +
+				`/orders/1/extra: expected <missing>, got true` + "\n" +
+				`/orders/1/price: expected 11, got 12`,
+		},
+		{
+			name:     "different types",
+			expected: `[1]`,
+			got:      `{"0": 1}`,
+			format:   "JSON not equal\n%s",
+			diff:     `(root): expected [1], got {"0":1}`,
+		},
+		{
+			name:     "invalid expected",
+			expected: `{`,
+			got:      `{}`,
+			format:   "could not parse expected JSON - %v",
+		},
+		{
+			name:     "trailing data in got",
+			expected: `{}`,
+			got:      `{} {}`,
+			format:   "could not parse got JSON - %v",
+		},
+		{
+			name:     "unsupported type",
+			expected: `{}`,
+			got:      map[string]interface{}{},
+			format:   "could not parse got JSON - %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeEqualJSON(test.expected, test.got)
+			checkResults(t, test.shouldPass, result, test.format, m)
+			if test.diff != "" && (len(m.args) != 1 || m.args[0] != test.diff) {
+				t.Errorf("Incorrect diff. Expected:\n%s\ngot:\n%v", test.diff, m.args)
+			}
+		})
+	}
+}
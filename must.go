@@ -1,11 +1,19 @@
 package must
 
+import "time"
+
 // TestingT is an interface wrapper around *testing.T
 type TestingT interface {
 	Errorf(format string, args ...interface{})
 	Helper()
 }
 
+// FatalTestingT extends TestingT with the ability to stop the current test, as provided by *testing.T
+type FatalTestingT interface {
+	TestingT
+	FailNow()
+}
+
 // MustTester defines an interface with functions matching the package level check functions, without the requirement to specify a TestingT.
 type MustTester interface {
 	BeEqual(expected, got interface{}, a ...interface{}) bool
@@ -13,5 +21,49 @@ type MustTester interface {
 	BeNoError(got error, a ...interface{}) bool
 	BeError(got error, a ...interface{}) bool
 	BeErrorIf(errorExpected bool, got error, a ...interface{}) bool
+	BeNil(got interface{}, a ...interface{}) bool
+	BeNotNil(got interface{}, a ...interface{}) bool
+	BeTrue(got bool, a ...interface{}) bool
+	BeFalse(got bool, a ...interface{}) bool
+	BeContainingString(substr, got string, a ...interface{}) bool
+	BeNotContainingString(substr, got string, a ...interface{}) bool
+	BeHavingPrefix(prefix, got string, a ...interface{}) bool
+	BeHavingSuffix(suffix, got string, a ...interface{}) bool
+	BeMatchingRegexp(pattern, got string, a ...interface{}) bool
+	BeErrorIs(target, got error, a ...interface{}) bool
+	BeErrorAs(got error, target interface{}, a ...interface{}) bool
+	BeErrorContaining(substr string, got error, a ...interface{}) bool
+	BeErrorMatching(pattern string, got error, a ...interface{}) bool
 	BeSameLength(expected, got interface{}, a ...interface{}) bool
+	BeLength(expected int, got interface{}, a ...interface{}) bool
+	BeEmpty(got interface{}, a ...interface{}) bool
+	BeNotEmpty(got interface{}, a ...interface{}) bool
+	BeContaining(container, element interface{}, a ...interface{}) bool
+	BeNotContaining(container, element interface{}, a ...interface{}) bool
+	BeSubsetOf(subset, superset interface{}, a ...interface{}) bool
+	BeEqualElements(expected, got interface{}, a ...interface{}) bool
+	BeEqualGolden(name, got string, a ...interface{}) bool
+	BeEqualGoldenValue(name string, got interface{}, a ...interface{}) bool
+	BeEqualJSON(expected, got interface{}, a ...interface{}) bool
+	BeEqualText(expected, got string, a ...interface{}) bool
+	BeEqualBytes(expected, got []byte, a ...interface{}) bool
+	BeApproxEqual(expected, got interface{}, tolerance Tolerance, a ...interface{}) bool
+	BeGreater(bound, got interface{}, a ...interface{}) bool
+	BeGreaterOrEqual(bound, got interface{}, a ...interface{}) bool
+	BeLess(bound, got interface{}, a ...interface{}) bool
+	BeLessOrEqual(bound, got interface{}, a ...interface{}) bool
+	BeBetween(lo, hi, got interface{}, a ...interface{}) bool
+	BeSorted(slice interface{}, less func(i, j int) bool, a ...interface{}) bool
+	BeSameTime(expected, got time.Time, a ...interface{}) bool
+	BeWithinDuration(expected, got time.Time, delta time.Duration, a ...interface{}) bool
+	BeBefore(bound, got time.Time, a ...interface{}) bool
+	BeAfter(bound, got time.Time, a ...interface{}) bool
+	BePanic(fn func(), a ...interface{}) bool
+	BePanicWith(expected interface{}, fn func(), a ...interface{}) bool
+	BeNoPanic(fn func(), a ...interface{}) bool
+	Eventually(condition func() bool, timeout, interval time.Duration, a ...interface{}) bool
+	Consistently(condition func() bool, duration, interval time.Duration, a ...interface{}) bool
+	EventuallyCheck(check func(MustTester), timeout, interval time.Duration, a ...interface{}) bool
+	ConsistentlyCheck(check func(MustTester), duration, interval time.Duration, a ...interface{}) bool
+	Group(name string, check func(MustTester), a ...interface{}) bool
 }
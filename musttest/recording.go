@@ -0,0 +1,251 @@
+/*
+Package musttest provides a recording implementation of must.TestingT, for testing helpers that are built on must.
+
+For example, to check that a custom helper fails as expected:
+
+	rt := &musttest.RecordingT{}
+	BeValidUser(rt, User{})
+	rt.ExpectFailures(t, 1)
+	rt.ExpectMessageContaining(t, "name is required")
+*/
+package musttest
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/theothertomelliott/must"
+)
+
+var _ must.FatalTestingT = &RecordingT{}
+
+// Call describes a single call to a RecordingT that reported a failure or log message.
+type Call struct {
+	Method  string        // Name of the method called, such as Errorf or Logf
+	Format  string        // Format string provided to the call, if any
+	Args    []interface{} // Arguments provided to the call
+	Message string        // Message formatted from Format and Args
+	File    string        // Source file of the caller, skipping any functions marked with Helper
+	Line    int           // Line number of the caller, skipping any functions marked with Helper
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("%s:%d: %s", c.File, c.Line, c.Message)
+}
+
+/*
+RecordingT implements must.TestingT, recording every call made to it rather than reporting to a real test.
+
+The zero value is ready to use. A RecordingT is safe for concurrent use.
+*/
+type RecordingT struct {
+	TestName string // Name returned by Name, for helpers that depend on the name of the test
+
+	mu       sync.Mutex
+	calls    []Call
+	helpers  map[string]bool
+	cleanups []func()
+	failed   bool
+}
+
+// Errorf records a failure with a message formatted from format and args, as with fmt.Sprintf.
+func (r *RecordingT) Errorf(format string, args ...interface{}) {
+	r.record("Errorf", format, args, true)
+}
+
+/*
+Fatalf records a failure with a message formatted from format and args, then stops the calling goroutine as with FailNow.
+*/
+func (r *RecordingT) Fatalf(format string, args ...interface{}) {
+	r.record("Fatalf", format, args, true)
+	runtime.Goexit()
+}
+
+// Logf records a log message formatted from format and args, as with fmt.Sprintf.
+func (r *RecordingT) Logf(format string, args ...interface{}) {
+	r.record("Logf", format, args, false)
+}
+
+/*
+FailNow marks the RecordingT as failed and stops the calling goroutine, as with *testing.T.
+
+Helpers that may call FailNow should be called within Run, so that only the goroutine started by Run is stopped.
+*/
+func (r *RecordingT) FailNow() {
+	r.record("FailNow", "", nil, true)
+	runtime.Goexit()
+}
+
+// Helper marks the calling function as a helper, so it will be skipped when recording the location of calls.
+func (r *RecordingT) Helper() {
+	var pc [1]uintptr
+	if runtime.Callers(2, pc[:]) == 0 {
+		return
+	}
+	frame, _ := runtime.CallersFrames(pc[:]).Next()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.helpers == nil {
+		r.helpers = make(map[string]bool)
+	}
+	r.helpers[frame.Function] = true
+}
+
+// Cleanup registers fn to be called by DoCleanup.
+func (r *RecordingT) Cleanup(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cleanups = append(r.cleanups, fn)
+}
+
+// DoCleanup calls every function registered with Cleanup, in the reverse order to which they were registered.
+func (r *RecordingT) DoCleanup() {
+	r.mu.Lock()
+	cleanups := r.cleanups
+	r.cleanups = nil
+	r.mu.Unlock()
+
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+// Name returns the TestName of the RecordingT.
+func (r *RecordingT) Name() string {
+	return r.TestName
+}
+
+// Failed reports whether any failures have been recorded.
+func (r *RecordingT) Failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed
+}
+
+/*
+Run calls fn in a new goroutine and waits for it to finish, so that calls to FailNow and Fatalf only stop fn.
+
+The return value will be true if fn returned normally. Should fn panic, the panic is propagated to the caller of Run.
+*/
+func (r *RecordingT) Run(fn func(t *RecordingT)) bool {
+	done := make(chan struct{})
+	var completed bool
+	var panicked bool
+	var panicValue interface{}
+	go func() {
+		defer close(done)
+		defer func() {
+			if !completed {
+				panicValue = recover()
+				panicked = panicValue != nil
+			}
+		}()
+		fn(r)
+		completed = true
+	}()
+	<-done
+	if panicked {
+		panic(panicValue)
+	}
+	return completed
+}
+
+// Calls returns every recorded call, in the order they were made.
+func (r *RecordingT) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// Failures returns every recorded call that reported a failure with a message, in the order they were made.
+func (r *RecordingT) Failures() []Call {
+	var failures []Call
+	for _, call := range r.Calls() {
+		if call.Method == "Errorf" || call.Method == "Fatalf" {
+			failures = append(failures, call)
+		}
+	}
+	return failures
+}
+
+/*
+ExpectFailures checks that exactly n failures were recorded, triggering an error on t listing the recorded failures if not.
+
+The return value will be true if n failures were recorded.
+*/
+func (r *RecordingT) ExpectFailures(t must.TestingT, n int) bool {
+	t.Helper()
+	failures := r.Failures()
+	if len(failures) == n {
+		return true
+	}
+	t.Errorf("expected %d failures, got %d%s", n, len(failures), listCalls(failures))
+	return false
+}
+
+/*
+ExpectMessageContaining checks that at least one recorded failure has a message containing s, triggering an error on t listing the recorded failures if not.
+
+The return value will be true if a matching failure was recorded.
+*/
+func (r *RecordingT) ExpectMessageContaining(t must.TestingT, s string) bool {
+	t.Helper()
+	failures := r.Failures()
+	for _, failure := range failures {
+		if strings.Contains(failure.Message, s) {
+			return true
+		}
+	}
+	t.Errorf("expected a failure containing %q, got %d failures%s", s, len(failures), listCalls(failures))
+	return false
+}
+
+func (r *RecordingT) record(method, format string, args []interface{}, failure bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	file, line := r.caller()
+	message := ""
+	if format != "" {
+		message = fmt.Sprintf(format, args...)
+	}
+	r.calls = append(r.calls, Call{
+		Method:  method,
+		Format:  format,
+		Args:    args,
+		Message: message,
+		File:    file,
+		Line:    line,
+	})
+	if failure {
+		r.failed = true
+	}
+}
+
+// caller finds the first caller outside of RecordingT that has not been marked as a helper.
+// It must be called with the lock held.
+func (r *RecordingT) caller() (string, int) {
+	pcs := make([]uintptr, 50)
+	n := runtime.Callers(4, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !r.helpers[frame.Function] {
+			return frame.File, frame.Line
+		}
+		if !more {
+			return frame.File, frame.Line
+		}
+	}
+}
+
+func listCalls(calls []Call) string {
+	var out strings.Builder
+	for _, call := range calls {
+		out.WriteString("\n  ")
+		out.WriteString(strings.Replace(call.String(), "\n", "\n  ", -1))
+	}
+	return out.String()
+}
@@ -0,0 +1,140 @@
+package musttest
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/theothertomelliott/must"
+)
+
+func TestRecordingTErrorf(t *testing.T) {
+	rt := &RecordingT{}
+	_, file, line, _ := runtime.Caller(0)
+	must.BeEqual(rt, 1, 2, "message")
+
+	failures := rt.Failures()
+	if len(failures) != 1 {
+		t.Fatalf("Expected 1 failure, got %d", len(failures))
+	}
+	if failures[0].Method != "Errorf" {
+		t.Errorf("Incorrect method, got %v", failures[0].Method)
+	}
+	if failures[0].Format != "%v: diff\n%s" {
+		t.Errorf("Incorrect format, got %v", failures[0].Format)
+	}
+	if failures[0].File != file || failures[0].Line != line+1 {
+		t.Errorf("Incorrect location. Expected %s:%d, got %s:%d", file, line+1, failures[0].File, failures[0].Line)
+	}
+	if !rt.Failed() {
+		t.Error("Expected RecordingT to be marked as failed")
+	}
+}
+
+func TestRecordingTEqualWithLocation(t *testing.T) {
+	rt := &RecordingT{}
+	tester := must.Tester{T: rt}
+	_, file, line, _ := runtime.Caller(0)
+	must.EqualWith(tester, 1, 2)
+
+	failures := rt.Failures()
+	if len(failures) != 1 {
+		t.Fatalf("Expected 1 failure, got %d", len(failures))
+	}
+	if failures[0].File != file || failures[0].Line != line+1 {
+		t.Errorf("Incorrect location. Expected %s:%d, got %s:%d", file, line+1, failures[0].File, failures[0].Line)
+	}
+}
+
+func TestRecordingTLogf(t *testing.T) {
+	rt := &RecordingT{}
+	rt.Logf("value: %d", 1)
+	if rt.Failed() {
+		t.Error("Logging should not mark RecordingT as failed")
+	}
+	calls := rt.Calls()
+	if len(calls) != 1 || calls[0].Message != "value: 1" {
+		t.Errorf("Incorrect calls, got %v", calls)
+	}
+	if len(rt.Failures()) != 0 {
+		t.Errorf("Expected no failures, got %v", rt.Failures())
+	}
+}
+
+func TestRecordingTRun(t *testing.T) {
+	rt := &RecordingT{}
+	var reachedEnd bool
+	completed := rt.Run(func(rt *RecordingT) {
+		must.Require(rt).BeNoError(errors.New("failed"))
+		reachedEnd = true
+	})
+	if completed || reachedEnd {
+		t.Error("Expected FailNow to stop the function")
+	}
+	calls := rt.Calls()
+	if len(calls) != 2 || calls[0].Method != "Errorf" || calls[1].Method != "FailNow" {
+		t.Errorf("Incorrect calls, got %v", calls)
+	}
+
+	if !rt.Run(func(rt *RecordingT) {}) {
+		t.Error("Expected function to complete")
+	}
+}
+
+func TestRecordingTRunPanic(t *testing.T) {
+	rt := &RecordingT{}
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Expected panic to be propagated, got %v", r)
+		}
+	}()
+	rt.Run(func(rt *RecordingT) {
+		panic("boom")
+	})
+}
+
+func TestRecordingTCleanup(t *testing.T) {
+	rt := &RecordingT{TestName: "TestSample"}
+	var order []int
+	rt.Cleanup(func() { order = append(order, 1) })
+	rt.Cleanup(func() { order = append(order, 2) })
+	rt.DoCleanup()
+	if len(order) != 2 || order[0] != 2 || order[1] != 1 {
+		t.Errorf("Incorrect cleanup order, got %v", order)
+	}
+	if rt.Name() != "TestSample" {
+		t.Errorf("Incorrect name, got %v", rt.Name())
+	}
+}
+
+func TestExpectFailures(t *testing.T) {
+	rt := &RecordingT{}
+	must.BeError(rt, nil)
+	must.BeError(rt, nil)
+
+	if !rt.ExpectFailures(t, 2) {
+		t.Error("Check did not pass as expected")
+	}
+
+	inner := &RecordingT{}
+	if rt.ExpectFailures(inner, 1) {
+		t.Error("Check did not fail as expected")
+	}
+	inner.ExpectFailures(t, 1)
+	inner.ExpectMessageContaining(t, "expected 1 failures, got 2")
+}
+
+func TestExpectMessageContaining(t *testing.T) {
+	rt := &RecordingT{}
+	must.BeNoError(rt, errors.New("file not found"))
+
+	if !rt.ExpectMessageContaining(t, "not found") {
+		t.Error("Check did not pass as expected")
+	}
+
+	inner := &RecordingT{}
+	if rt.ExpectMessageContaining(inner, "permission denied") {
+		t.Error("Check did not fail as expected")
+	}
+	inner.ExpectFailures(t, 1)
+}
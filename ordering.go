@@ -0,0 +1,247 @@
+package must
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Ordered is a constraint satisfied by any type that supports the operators < <= >= >.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+/*
+BeGreater checks that got is greater than bound, triggering an error on t if it is not.
+
+The return value will be true if got > bound.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeGreater[T Ordered](t TestingT, bound, got T, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeGreater(bound, got, a...)
+}
+
+/*
+BeGreaterOrEqual checks that got is greater than or equal to bound, triggering an error on t if it is not.
+
+The return value will be true if got >= bound.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeGreaterOrEqual[T Ordered](t TestingT, bound, got T, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeGreaterOrEqual(bound, got, a...)
+}
+
+/*
+BeLess checks that got is less than bound, triggering an error on t if it is not.
+
+The return value will be true if got < bound.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeLess[T Ordered](t TestingT, bound, got T, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeLess(bound, got, a...)
+}
+
+/*
+BeLessOrEqual checks that got is less than or equal to bound, triggering an error on t if it is not.
+
+The return value will be true if got <= bound.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeLessOrEqual[T Ordered](t TestingT, bound, got T, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeLessOrEqual(bound, got, a...)
+}
+
+/*
+BeBetween checks that got is within the inclusive range from lo to hi, triggering an error on t if it is not.
+
+The return value will be true if lo <= got <= hi.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeBetween[T Ordered](t TestingT, lo, hi, got T, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeBetween(lo, hi, got, a...)
+}
+
+/*
+BeSorted checks that the elements of s are sorted according to less, triggering an error on t if they are not.
+Equal elements may appear in any order. Should less be nil, elements of ordered types will be compared by their natural order.
+
+Should s not be sorted, the error will include the first pair of elements that are out of order, with their indices.
+
+The return value will be true if s is sorted.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeSorted[T any](t TestingT, s []T, less func(a, b T) bool, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	if less == nil {
+		return mt.BeSorted(s, nil, a...)
+	}
+	return mt.BeSorted(s, func(i, j int) bool {
+		return less(s[i], s[j])
+	}, a...)
+}
+
+/*
+BeGreater checks that got is greater than bound, triggering an error on the Tester's T if it is not.
+Both values must be of the same type, with an underlying integer, floating point or string type.
+
+This corresponds to the function BeGreater
+*/
+func (tester Tester) BeGreater(bound, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	return tester.checkOrder(bound, got, "greater than", func(c int) bool { return c > 0 }, a)
+}
+
+/*
+BeGreaterOrEqual checks that got is greater than or equal to bound, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeGreaterOrEqual
+*/
+func (tester Tester) BeGreaterOrEqual(bound, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	return tester.checkOrder(bound, got, "greater than or equal to", func(c int) bool { return c >= 0 }, a)
+}
+
+/*
+BeLess checks that got is less than bound, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeLess
+*/
+func (tester Tester) BeLess(bound, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	return tester.checkOrder(bound, got, "less than", func(c int) bool { return c < 0 }, a)
+}
+
+/*
+BeLessOrEqual checks that got is less than or equal to bound, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeLessOrEqual
+*/
+func (tester Tester) BeLessOrEqual(bound, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	return tester.checkOrder(bound, got, "less than or equal to", func(c int) bool { return c <= 0 }, a)
+}
+
+/*
+BeBetween checks that got is within the inclusive range from lo to hi, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeBetween
+*/
+func (tester Tester) BeBetween(lo, hi, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	aboveLo, err := compareOrdered(got, lo)
+	if err != nil {
+		tester.formattedError("could not compare values - %v", a, err)
+		return false
+	}
+	belowHi, err := compareOrdered(got, hi)
+	if err != nil {
+		tester.formattedError("could not compare values - %v", a, err)
+		return false
+	}
+	if aboveLo >= 0 && belowHi <= 0 {
+		return true
+	}
+	tester.formattedError("expected %v to be between %v and %v", a, got, lo, hi)
+	return false
+}
+
+/*
+BeSorted checks that the elements of slice are sorted according to less, triggering an error on the Tester's T if they are not.
+As with sort.Slice, less reports whether the element at index i should sort before the element at index j.
+Should less be nil, elements will be compared by their natural order, as with BeLess.
+
+This corresponds to the function BeSorted
+*/
+func (tester Tester) BeSorted(slice interface{}, less func(i, j int) bool, a ...interface{}) bool {
+	tester.T.Helper()
+	val := reflect.ValueOf(slice)
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		tester.formattedError("could not check order - cannot sort type: %T", a, slice)
+		return false
+	}
+	for i := 1; i < val.Len(); i++ {
+		outOfOrder := false
+		if less != nil {
+			outOfOrder = less(i, i-1)
+		} else {
+			c, err := compareOrdered(val.Index(i).Interface(), val.Index(i-1).Interface())
+			if err != nil {
+				tester.formattedError("could not check order - %v", a, err)
+				return false
+			}
+			outOfOrder = c < 0
+		}
+		if outOfOrder {
+			tester.formattedError("not sorted: index %d (%s) should not come after index %d (%s)", a, i, formatCompactValue(val.Index(i)), i-1, formatCompactValue(val.Index(i-1)))
+			return false
+		}
+	}
+	return true
+}
+
+// checkOrder compares got against bound, triggering an error unless the result of the comparison satisfies pass.
+func (tester Tester) checkOrder(bound, got interface{}, relation string, pass func(int) bool, a []interface{}) bool {
+	tester.T.Helper()
+	c, err := compareOrdered(got, bound)
+	if err != nil {
+		tester.formattedError("could not compare values - %v", a, err)
+		return false
+	}
+	if pass(c) {
+		return true
+	}
+	tester.formattedError("expected %v to be %s %v", a, got, relation, bound)
+	return false
+}
+
+// compareOrdered returns -1, 0 or 1 as x is less than, equal to or greater than y, which must be of the same ordered type.
+func compareOrdered(x, y interface{}) (int, error) {
+	xv, yv := reflect.ValueOf(x), reflect.ValueOf(y)
+	if !xv.IsValid() || !yv.IsValid() || xv.Type() != yv.Type() {
+		return 0, fmt.Errorf("cannot compare %T with %T", x, y)
+	}
+	switch xv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return sign(xv.Int() > yv.Int(), xv.Int() < yv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return sign(xv.Uint() > yv.Uint(), xv.Uint() < yv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(xv.Float()) || math.IsNaN(yv.Float()) {
+			return 0, fmt.Errorf("cannot order NaN")
+		}
+		return sign(xv.Float() > yv.Float(), xv.Float() < yv.Float()), nil
+	case reflect.String:
+		return sign(xv.String() > yv.String(), xv.String() < yv.String()), nil
+	}
+	return 0, fmt.Errorf("cannot order type: %T", x)
+}
+
+func sign(greater, less bool) int {
+	switch {
+	case greater:
+		return 1
+	case less:
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,150 @@
+package must
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestOrderingChecks(t *testing.T) {
+	var tests = []struct {
+		name       string
+		check      func(MustTester) bool
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "greater",
+			check:      func(mt MustTester) bool { return mt.BeGreater(1, 2) },
+			shouldPass: true,
+		},
+		{
+			name:   "not greater when equal",
+			check:  func(mt MustTester) bool { return mt.BeGreater(2, 2) },
+			format: "expected %v to be %s %v",
+		},
+		{
+			name:       "greater or equal",
+			check:      func(mt MustTester) bool { return mt.BeGreaterOrEqual(2, 2) },
+			shouldPass: true,
+		},
+		{
+			name:       "less with strings",
+			check:      func(mt MustTester) bool { return mt.BeLess("b", "a") },
+			shouldPass: true,
+		},
+		{
+			name:   "not less",
+			check:  func(mt MustTester) bool { return mt.BeLess(1.5, 2.5) },
+			format: "expected %v to be %s %v",
+		},
+		{
+			name:       "less or equal with durations",
+			check:      func(mt MustTester) bool { return mt.BeLessOrEqual(time.Second, time.Second) },
+			shouldPass: true,
+		},
+		{
+			name:   "different types",
+			check:  func(mt MustTester) bool { return mt.BeGreater(1, int64(2)) },
+			format: "could not compare values - %v",
+		},
+		{
+			name:   "NaN",
+			check:  func(mt MustTester) bool { return mt.BeGreater(1.0, math.NaN()) },
+			format: "could not compare values - %v",
+		},
+		{
+			name:   "unordered type",
+			check:  func(mt MustTester) bool { return mt.BeGreater(true, false) },
+			format: "could not compare values - %v",
+		},
+		{
+			name:       "between",
+			check:      func(mt MustTester) bool { return mt.BeBetween(uint(1), uint(3), uint(3)) },
+			shouldPass: true,
+		},
+		{
+			name:   "not between",
+			check:  func(mt MustTester) bool { return mt.BeBetween(1, 3, 4) },
+			format: "expected %v to be between %v and %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := test.check(tester)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestGenericOrderingChecks(t *testing.T) {
+	m := &MockTesting{}
+	if !BeGreater(m, 100*time.Millisecond, 150*time.Millisecond) {
+		t.Error("BeGreater did not pass as expected")
+	}
+	if !BeGreaterOrEqual(m, 1.5, 1.5) {
+		t.Error("BeGreaterOrEqual did not pass as expected")
+	}
+	if !BeLess(m, "b", "a") {
+		t.Error("BeLess did not pass as expected")
+	}
+	if !BeLessOrEqual(m, 3, 2) {
+		t.Error("BeLessOrEqual did not pass as expected")
+	}
+	if BeBetween(m, 1, 3, 0, "message") {
+		t.Error("BeBetween did not fail as expected")
+	}
+	if m.format != "%v: expected %v to be between %v and %v" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+}
+
+func TestBeSorted(t *testing.T) {
+	type item struct {
+		Name string
+		Rank int
+	}
+	byRank := func(a, b item) bool { return a.Rank < b.Rank }
+
+	m := &MockTesting{}
+	if !BeSorted(m, []item{{"a", 1}, {"b", 1}, {"c", 2}}, byRank) {
+		t.Error("Check did not pass as expected")
+	}
+	if !BeSorted(m, []string{"a", "b", "b", "c"}, nil) {
+		t.Error("Check did not pass as expected with natural order")
+	}
+	if m.errorCalled {
+		t.Errorf("Error was raised for sorted slices: %v", m.args)
+	}
+
+	if BeSorted(m, []item{{"a", 1}, {"b", 3}, {"c", 2}}, byRank) {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "not sorted: index %d (%s) should not come after index %d (%s)" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+	expectedArgs := []interface{}{2, `{Name:"c",Rank:2}`, 1, `{Name:"b",Rank:3}`}
+	if len(m.args) != len(expectedArgs) {
+		t.Fatalf("Incorrect error args, got %v", m.args)
+	}
+	for i := range expectedArgs {
+		if m.args[i] != expectedArgs[i] {
+			t.Errorf("Incorrect error arg %d. Expected %v, got %v", i, expectedArgs[i], m.args[i])
+		}
+	}
+
+	tester := Tester{T: m}
+	if tester.BeSorted(3, nil) {
+		t.Error("Check did not fail for a non-slice")
+	}
+	if tester.BeSorted([]bool{true, false}, nil) {
+		t.Error("Check did not fail for unordered elements")
+	}
+	if m.format != "could not check order - %v" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+}
@@ -0,0 +1,106 @@
+package must
+
+import "runtime/debug"
+
+/*
+BePanic calls fn, triggering an error on t if it does not panic.
+
+The return value will be true if fn panicked.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BePanic(t TestingT, fn func(), a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BePanic(fn, a...)
+}
+
+/*
+BePanicWith calls fn, triggering an error on t if it does not panic with a value equal to expected.
+The recovered value is compared with expected in the same way as BeEqual, and the error will include a diff of the two.
+
+The return value will be true if fn panicked with the expected value.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BePanicWith(t TestingT, expected interface{}, fn func(), a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BePanicWith(expected, fn, a...)
+}
+
+/*
+BeNoPanic calls fn, triggering an error on t if it panics.
+The error will include the recovered value and the stack trace of the panicking goroutine.
+
+The return value will be true if fn returned without panicking.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeNoPanic(t TestingT, fn func(), a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeNoPanic(fn, a...)
+}
+
+/*
+BePanic calls fn, triggering an error on the Tester's T if it does not panic.
+
+This corresponds to the function BePanic
+*/
+func (tester Tester) BePanic(fn func(), a ...interface{}) bool {
+	tester.T.Helper()
+	if panicked, _, _ := recoverPanic(fn); panicked {
+		return true
+	}
+	tester.formattedError("expected a panic, but the function returned normally", a)
+	return false
+}
+
+/*
+BePanicWith calls fn, triggering an error on the Tester's T if it does not panic with the expected value.
+
+This corresponds to the function BePanicWith
+*/
+func (tester Tester) BePanicWith(expected interface{}, fn func(), a ...interface{}) bool {
+	tester.T.Helper()
+	panicked, value, _ := recoverPanic(fn)
+	if !panicked {
+		tester.formattedError("expected a panic with '%v', but the function returned normally", a, expected)
+		return false
+	}
+	if !tester.equal(expected, value) {
+		tester.formattedError("panic value did not match, diff\n%s", a, tester.diff(expected, value))
+		return false
+	}
+	return true
+}
+
+/*
+BeNoPanic calls fn, triggering an error on the Tester's T if it panics.
+
+This corresponds to the function BeNoPanic
+*/
+func (tester Tester) BeNoPanic(fn func(), a ...interface{}) bool {
+	tester.T.Helper()
+	panicked, value, stack := recoverPanic(fn)
+	if !panicked {
+		return true
+	}
+	tester.formattedError("unexpected panic: %v\n%s", a, value, stack)
+	return false
+}
+
+// recoverPanic calls fn, returning the recovered value and stack trace should it panic.
+func recoverPanic(fn func()) (panicked bool, value interface{}, stack string) {
+	panicked = true
+	defer func() {
+		if panicked {
+			value = recover()
+			stack = string(debug.Stack())
+		}
+	}()
+	fn()
+	panicked = false
+	return
+}
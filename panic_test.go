@@ -0,0 +1,97 @@
+package must
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBePanic(t *testing.T) {
+	m := &MockTesting{}
+	if !BePanic(m, func() { panic("boom") }) {
+		t.Error("Check did not pass as expected")
+	}
+	if BePanic(m, func() {}, "message") {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "%v: expected a panic, but the function returned normally" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+}
+
+func TestBePanicWith(t *testing.T) {
+	var tests = []struct {
+		name       string
+		expected   interface{}
+		fn         func()
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "matching value",
+			expected:   "boom",
+			fn:         func() { panic("boom") },
+			shouldPass: true,
+		},
+		{
+			name:       "matching error",
+			expected:   errors.New("boom"),
+			fn:         func() { panic(errors.New("boom")) },
+			shouldPass: true,
+		},
+		{
+			name:     "different value",
+			expected: "boom",
+			fn:       func() { panic("bang") },
+			format:   "panic value did not match, diff\n%s",
+		},
+		{
+			name:     "no panic",
+			expected: "boom",
+			fn:       func() {},
+			format:   "expected a panic with '%v', but the function returned normally",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BePanicWith(test.expected, test.fn)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestBePanicWithCustomCompare(t *testing.T) {
+	m := &MockTesting{}
+	tester := Tester{
+		T: m,
+		InterfaceComparison: func(expected, got interface{}) bool {
+			return true
+		},
+	}
+	if !tester.BePanicWith("boom", func() { panic("bang") }) {
+		t.Error("Custom comparison was not used")
+	}
+}
+
+func TestBeNoPanic(t *testing.T) {
+	m := &MockTesting{}
+	if !BeNoPanic(m, func() {}) {
+		t.Error("Check did not pass as expected")
+	}
+	if BeNoPanic(m, func() { panic("boom") }) {
+		t.Error("Check did not fail as expected")
+	}
+	if m.format != "unexpected panic: %v\n%s" {
+		t.Errorf("Incorrect error format, got '%v'", m.format)
+	}
+	if len(m.args) != 2 || m.args[0] != "boom" {
+		t.Fatalf("Incorrect error args, got %v", m.args)
+	}
+	if stack, _ := m.args[1].(string); !strings.Contains(stack, "goroutine") {
+		t.Errorf("Expected stack trace, got %v", m.args[1])
+	}
+}
@@ -0,0 +1,50 @@
+package must
+
+import (
+	"fmt"
+	"strings"
+)
+
+var _ TestingT = &recorder{}
+
+// recorder is a TestingT that records errors instead of reporting them, so a Tester can decide how to report them later.
+type recorder struct {
+	errors []recordedError
+}
+
+type recordedError struct {
+	format string
+	args   []interface{}
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, recordedError{format: format, args: args})
+}
+
+func (r *recorder) Helper() {
+	// Nothing to do
+}
+
+func (r *recorder) failed() bool {
+	return len(r.errors) > 0
+}
+
+// messages returns each recorded error formatted as it would have been reported.
+func (r *recorder) messages() []string {
+	var out []string
+	for _, e := range r.errors {
+		out = append(out, fmt.Sprintf(e.format, e.args...))
+	}
+	return out
+}
+
+// recording returns a copy of the Tester that records errors to rec, and never stops the test.
+func (tester Tester) recording(rec *recorder) Tester {
+	tester.T = rec
+	tester.Fatal = false
+	return tester
+}
+
+func indent(s string) string {
+	return "  " + strings.Replace(s, "\n", "\n  ", -1)
+}
@@ -0,0 +1,159 @@
+package must
+
+import (
+	"regexp"
+	"strings"
+)
+
+/*
+BeContainingString checks that got contains substr, triggering an error on t if it does not.
+
+Should got not contain substr, the error will show got with line numbers, with the longest part of substr that was found marked.
+
+The return value will be true if got contains substr.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeContainingString(t TestingT, substr, got string, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeContainingString(substr, got, a...)
+}
+
+/*
+BeNotContainingString checks that got does not contain substr, triggering an error on t if it does.
+
+Should got contain substr, the error will show got with line numbers, with the first occurrence of substr marked.
+
+The return value will be true if got does not contain substr.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeNotContainingString(t TestingT, substr, got string, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeNotContainingString(substr, got, a...)
+}
+
+/*
+BeHavingPrefix checks that got begins with prefix, triggering an error on t if it does not.
+
+Should got not begin with prefix, the error will show got with line numbers, with the part of prefix that did match marked.
+
+The return value will be true if got begins with prefix.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeHavingPrefix(t TestingT, prefix, got string, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeHavingPrefix(prefix, got, a...)
+}
+
+/*
+BeHavingSuffix checks that got ends with suffix, triggering an error on t if it does not.
+
+Should got not end with suffix, the error will show got with line numbers, with the part of suffix that did match marked.
+
+The return value will be true if got ends with suffix.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeHavingSuffix(t TestingT, suffix, got string, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeHavingSuffix(suffix, got, a...)
+}
+
+/*
+BeMatchingRegexp checks that got matches the regular expression pattern, triggering an error on t if it does not.
+
+Should got not match, the error will show got with line numbers, with the match for the longest valid prefix of pattern marked.
+
+The return value will be true if got matches pattern.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeMatchingRegexp(t TestingT, pattern, got string, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeMatchingRegexp(pattern, got, a...)
+}
+
+/*
+BeContainingString checks that got contains substr, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeContainingString
+*/
+func (tester Tester) BeContainingString(substr, got string, a ...interface{}) bool {
+	tester.T.Helper()
+	if strings.Contains(got, substr) {
+		return true
+	}
+	start, end := partialMatch(got, substr)
+	tester.formattedError("expected string to contain %q, got:\n%s", a, substr, markedText(got, start, end))
+	return false
+}
+
+/*
+BeNotContainingString checks that got does not contain substr, triggering an error on the Tester's T if it does.
+
+This corresponds to the function BeNotContainingString
+*/
+func (tester Tester) BeNotContainingString(substr, got string, a ...interface{}) bool {
+	tester.T.Helper()
+	start := strings.Index(got, substr)
+	if start < 0 {
+		return true
+	}
+	tester.formattedError("expected string not to contain %q, got:\n%s", a, substr, markedText(got, start, start+len(substr)))
+	return false
+}
+
+/*
+BeHavingPrefix checks that got begins with prefix, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeHavingPrefix
+*/
+func (tester Tester) BeHavingPrefix(prefix, got string, a ...interface{}) bool {
+	tester.T.Helper()
+	if strings.HasPrefix(got, prefix) {
+		return true
+	}
+	tester.formattedError("expected string to have prefix %q, got:\n%s", a, prefix, markedText(got, 0, commonPrefixLength(got, prefix)))
+	return false
+}
+
+/*
+BeHavingSuffix checks that got ends with suffix, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeHavingSuffix
+*/
+func (tester Tester) BeHavingSuffix(suffix, got string, a ...interface{}) bool {
+	tester.T.Helper()
+	if strings.HasSuffix(got, suffix) {
+		return true
+	}
+	tester.formattedError("expected string to have suffix %q, got:\n%s", a, suffix, markedText(got, len(got)-commonSuffixLength(got, suffix), len(got)))
+	return false
+}
+
+/*
+BeMatchingRegexp checks that got matches the regular expression pattern, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeMatchingRegexp
+*/
+func (tester Tester) BeMatchingRegexp(pattern, got string, a ...interface{}) bool {
+	tester.T.Helper()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		tester.formattedError("invalid pattern - %v", a, err)
+		return false
+	}
+	if re.MatchString(got) {
+		return true
+	}
+	start, end := partialRegexpMatch(got, pattern)
+	tester.formattedError("expected string to match %q, got:\n%s", a, pattern, markedText(got, start, end))
+	return false
+}
@@ -0,0 +1,88 @@
+package must
+
+import "testing"
+
+func TestStringChecks(t *testing.T) {
+	const log = "starting server\nlistening on :8080\nready"
+	var tests = []struct {
+		name       string
+		check      func(MustTester) bool
+		shouldPass bool
+		format     string
+		rendered   string
+	}{
+		{
+			name:       "containing",
+			check:      func(mt MustTester) bool { return mt.BeContainingString("listening", log) },
+			shouldPass: true,
+		},
+		{
+			name:     "not containing",
+			check:    func(mt MustTester) bool { return mt.BeContainingString("listening on :9090", log) },
+			format:   "expected string to contain %q, got:\n%s",
+			rendered: "1 | starting server\n2 | listening on :8080\n  | ^^^^^^^^^^^^^^\n3 | ready",
+		},
+		{
+			name:       "not containing as expected",
+			check:      func(mt MustTester) bool { return mt.BeNotContainingString("error", log) },
+			shouldPass: true,
+		},
+		{
+			name:     "unexpectedly containing",
+			check:    func(mt MustTester) bool { return mt.BeNotContainingString("ready", log) },
+			format:   "expected string not to contain %q, got:\n%s",
+			rendered: "1 | starting server\n2 | listening on :8080\n3 | ready\n  | ^^^^^",
+		},
+		{
+			name:       "prefix",
+			check:      func(mt MustTester) bool { return mt.BeHavingPrefix("starting", log) },
+			shouldPass: true,
+		},
+		{
+			name:     "wrong prefix",
+			check:    func(mt MustTester) bool { return mt.BeHavingPrefix("start client", "start server") },
+			format:   "expected string to have prefix %q, got:\n%s",
+			rendered: "1 | start server\n  | ^^^^^^",
+		},
+		{
+			name:       "suffix",
+			check:      func(mt MustTester) bool { return mt.BeHavingSuffix("ready", log) },
+			shouldPass: true,
+		},
+		{
+			name:     "wrong suffix",
+			check:    func(mt MustTester) bool { return mt.BeHavingSuffix("file.json", "file.yaml") },
+			format:   "expected string to have suffix %q, got:\n%s",
+			rendered: "1 | file.yaml",
+		},
+		{
+			name:       "matching",
+			check:      func(mt MustTester) bool { return mt.BeMatchingRegexp(`on :\d+`, log) },
+			shouldPass: true,
+		},
+		{
+			name:     "not matching",
+			check:    func(mt MustTester) bool { return mt.BeMatchingRegexp(`on :\d+ \(tls\)`, log) },
+			format:   "expected string to match %q, got:\n%s",
+			rendered: "1 | starting server\n2 | listening on :8080\n  |           ^^^^^^^^\n3 | ready",
+		},
+		{
+			name:   "invalid pattern",
+			check:  func(mt MustTester) bool { return mt.BeMatchingRegexp(`(`, log) },
+			format: "invalid pattern - %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := test.check(tester)
+			checkResults(t, test.shouldPass, result, test.format, m)
+			if test.rendered != "" && (len(m.args) != 2 || m.args[1] != test.rendered) {
+				t.Errorf("Incorrect output. Expected:\n%s\ngot:\n%v", test.rendered, m.args)
+			}
+		})
+	}
+}
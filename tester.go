@@ -1,8 +1,11 @@
 package must
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/kylelemons/godebug/diff"
 	"github.com/kylelemons/godebug/pretty"
@@ -17,6 +20,19 @@ type Tester struct {
 	T                   TestingT                               // *testing.T or equivalent
 	InterfaceComparison func(expected, got interface{}) bool   // Optional custom interface comparison function
 	InterfaceDiff       func(expected, got interface{}) string // Optional custom interace diff function
+	Fatal               bool                                   // Optional, stop the test after the first failed check. Panics if T does not implement FatalTestingT
+	Options             CompareOptions                         // Optional options for comparing values, ignored when InterfaceComparison is set
+	DiffStyle           DiffStyle                              // Optional style for rendering diffs, ignored when InterfaceDiff is set
+	Color               ColorMode                              // Optional, whether to color diffs. Defaults to ColorAuto
+}
+
+/*
+Require creates a Tester that stops the test by calling FailNow on t after reporting the first failed check.
+
+This is useful when later steps in a test depend on a check passing, such as using a result only after BeNoError.
+*/
+func Require(t FatalTestingT) Tester {
+	return Tester{T: t, Fatal: true}
 }
 
 /*
@@ -60,6 +76,10 @@ func (tester Tester) BeNoError(got error, a ...interface{}) bool {
 	if got == nil {
 		return true
 	}
+	if isNil(got) {
+		tester.formattedError("error: got a nil %T, which is not equal to nil when stored in an error", a, got)
+		return false
+	}
 	tester.formattedError("error: %s", a, got.Error())
 	return false
 }
@@ -89,16 +109,109 @@ func (tester Tester) BeSameLength(expected, got interface{}, a ...interface{}) b
 	return false
 }
 
+/*
+BeLength checks whether got has the expected length according to the len function.
+
+This corresponds to the function BeLength
+*/
+func (tester Tester) BeLength(expected int, got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	length, err := lenterface(got)
+	if err != nil {
+		tester.formattedError("could not test length - %v", a, err)
+		return false
+	}
+	if length == expected {
+		return true
+	}
+	tester.formattedError("expected length %d, got length %d: %s", a, expected, length, preview(got))
+	return false
+}
+
+/*
+BeEmpty checks whether got has a length of zero, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeEmpty
+*/
+func (tester Tester) BeEmpty(got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	length, err := lenterface(got)
+	if err != nil {
+		tester.formattedError("could not test length - %v", a, err)
+		return false
+	}
+	if length == 0 {
+		return true
+	}
+	tester.formattedError("expected empty, got length %d: %s", a, length, preview(got))
+	return false
+}
+
+/*
+BeNotEmpty checks whether got has a length greater than zero, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeNotEmpty
+*/
+func (tester Tester) BeNotEmpty(got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	length, err := lenterface(got)
+	if err != nil {
+		tester.formattedError("could not test length - %v", a, err)
+		return false
+	}
+	if length > 0 {
+		return true
+	}
+	tester.formattedError("expected not empty, got %s", a, preview(got))
+	return false
+}
+
 // BeError checks that the received error is not nil
 func (tester Tester) BeError(got error, a ...interface{}) bool {
 	tester.T.Helper()
-	if got != nil {
+	if got == nil {
+		tester.formattedError("expected an error, but got nil", a)
+		return false
+	}
+	if isNil(got) {
+		tester.formattedError("expected an error, but got a nil %T, which is not equal to nil when stored in an error", a, got)
+		return false
+	}
+	return true
+}
+
+/*
+BeNil checks that got is nil, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeNil
+*/
+func (tester Tester) BeNil(got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	if isNil(got) {
 		return true
 	}
-	tester.formattedError("expected an error, but got nil", a)
+	tester.formattedError("expected nil, got %s", a, formatCompact(got))
 	return false
 }
 
+/*
+BeNotNil checks that got is not nil, triggering an error on the Tester's T if it is.
+
+This corresponds to the function BeNotNil
+*/
+func (tester Tester) BeNotNil(got interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	if got == nil {
+		tester.formattedError("expected a non-nil value, got nil", a)
+		return false
+	}
+	if isNil(got) {
+		tester.formattedError("expected a non-nil value, got a nil %T", a, got)
+		return false
+	}
+	return true
+}
+
 // BeErrorIf checks that the received error corresponds to the errorExpected flag
 func (tester Tester) BeErrorIf(errorExpected bool, got error, a ...interface{}) bool {
 	tester.T.Helper()
@@ -109,7 +222,109 @@ func (tester Tester) BeErrorIf(errorExpected bool, got error, a ...interface{})
 	return tester.BeNoError(got, a...)
 }
 
+/*
+BeErrorIs checks that target is in the chain of got, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeErrorIs
+*/
+func (tester Tester) BeErrorIs(target, got error, a ...interface{}) bool {
+	tester.T.Helper()
+	if errors.Is(got, target) {
+		return true
+	}
+	tester.formattedError("expected error chain to contain '%v', got:\n%s", a, getErrMessage(target), errorChain(got))
+	return false
+}
+
+/*
+BeErrorAs checks that an error in the chain of got can be assigned to target, triggering an error on the Tester's T if none can.
+
+This corresponds to the function BeErrorAs
+*/
+func (tester Tester) BeErrorAs(got error, target interface{}, a ...interface{}) bool {
+	tester.T.Helper()
+	targetType, err := errorAsTargetType(target)
+	if err != nil {
+		tester.formattedError("invalid target - %v", a, err)
+		return false
+	}
+	if errors.As(got, target) {
+		return true
+	}
+	tester.formattedError("expected error chain to contain %v, got:\n%s", a, targetType, errorChain(got))
+	return false
+}
+
+/*
+BeErrorContaining checks that got has a message containing substr, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeErrorContaining
+*/
+func (tester Tester) BeErrorContaining(substr string, got error, a ...interface{}) bool {
+	tester.T.Helper()
+	if got == nil {
+		tester.formattedError("expected an error containing %q, but got nil", a, substr)
+		return false
+	}
+	if isNil(got) {
+		tester.formattedError("expected an error containing %q, but got a nil %T, which is not equal to nil when stored in an error", a, substr, got)
+		return false
+	}
+	message := got.Error()
+	if strings.Contains(message, substr) {
+		return true
+	}
+	start, end := partialMatch(message, substr)
+	tester.formattedError("expected error message to contain %q, got:\n%s", a, substr, markedText(message, start, end))
+	return false
+}
+
+/*
+BeErrorMatching checks that got has a message matching the regular expression pattern, triggering an error on the Tester's T if it does not.
+
+This corresponds to the function BeErrorMatching
+*/
+func (tester Tester) BeErrorMatching(pattern string, got error, a ...interface{}) bool {
+	tester.T.Helper()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		tester.formattedError("invalid pattern - %v", a, err)
+		return false
+	}
+	if got == nil {
+		tester.formattedError("expected an error matching %q, but got nil", a, pattern)
+		return false
+	}
+	if isNil(got) {
+		tester.formattedError("expected an error matching %q, but got a nil %T, which is not equal to nil when stored in an error", a, pattern, got)
+		return false
+	}
+	message := got.Error()
+	if re.MatchString(message) {
+		return true
+	}
+	start, end := partialRegexpMatch(message, pattern)
+	tester.formattedError("expected error message to match %q, got:\n%s", a, pattern, markedText(message, start, end))
+	return false
+}
+
+// isNil reports whether val is nil, or holds a nil pointer, map, slice, channel, function or interface.
+func isNil(val interface{}) bool {
+	if val == nil {
+		return true
+	}
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		return v.IsNil()
+	}
+	return false
+}
+
 func lenterface(val interface{}) (int, error) {
+	if val == nil {
+		return 0, nil
+	}
 	kind := reflect.TypeOf(val).Kind()
 	switch kind {
 	case reflect.Slice, reflect.Map, reflect.String, reflect.Chan, reflect.Array:
@@ -122,18 +337,24 @@ func lenterface(val interface{}) (int, error) {
 }
 
 func lenterfacePtr(val reflect.Value) (int, error) {
-	i := reflect.Indirect(val)
-	switch i.Kind() {
+	kind := val.Type().Elem().Kind()
+	switch kind {
 	case reflect.Slice, reflect.Map, reflect.String, reflect.Chan, reflect.Array:
-		return i.Len(), nil
+		if val.IsNil() {
+			return 0, nil
+		}
+		return val.Elem().Len(), nil
 	}
-	return 0, fmt.Errorf("cannot get the length of a pointer to type: %v", i.Kind())
+	return 0, fmt.Errorf("cannot get the length of a pointer to type: %v", kind)
 }
 
 func (tester Tester) equal(expected, got interface{}) bool {
 	if tester.InterfaceComparison != nil {
 		return tester.InterfaceComparison(expected, got)
 	}
+	if !tester.Options.isZero() {
+		return len(differences(expected, got, tester.Options)) == 0
+	}
 	return pretty.Compare(expected, got) == ""
 }
 
@@ -141,15 +362,43 @@ func (tester Tester) diff(expected, got interface{}) string {
 	if tester.InterfaceDiff != nil {
 		return tester.InterfaceDiff(expected, got)
 	}
+	style := tester.style()
 
 	// Do string diff if strings. Compare does not handle multiline strings well
 	e, eok := expected.(string)
 	g, gok := got.(string)
+	// Byte slices are diffed as strings if they hold a single line of text, otherwise as a hex dump
+	eb, ebok := expected.([]byte)
+	gb, gbok := got.([]byte)
+	if ebok && gbok {
+		if !textBytes(eb) || !textBytes(gb) || !singleLine(string(eb)) || !singleLine(string(gb)) {
+			return style.lines(hexDiff(eb, gb))
+		}
+		e, eok, g, gok = string(eb), true, string(gb), true
+	}
 	if eok && gok {
-		return fmt.Sprintf("(- expected, + got)\n%v", diff.Diff(e, g))
+		e, g = tester.Options.Text.normalize(e), tester.Options.Text.normalize(g)
+		inline := singleLine(e) && singleLine(g)
+		if onlyWhitespaceDiffers(e, g) {
+			e, g = visibleWhitespace(e), visibleWhitespace(g)
+		}
+		if inline {
+			return fmt.Sprintf("%s\n%s", style.header(inlineDiffHeader), inlineDiff(e, g, style))
+		}
+		return style.lines(fmt.Sprintf("(- expected, + got)\n%v", diff.Diff(e, g)))
 	}
 
-	return fmt.Sprintf("(- expected, + got)\n%v", pretty.Compare(expected, got))
+	if tester.DiffStyle == DiffPaths {
+		if diffs := differences(expected, got, tester.Options); len(diffs) > 0 {
+			return renderPaths(diffs)
+		}
+	}
+
+	if !tester.Options.isZero() {
+		return style.lines(fmt.Sprintf("(- expected, + got)\n%v", renderDifferences(differences(expected, got, tester.Options))))
+	}
+
+	return style.lines(fmt.Sprintf("(- expected, + got)\n%v", pretty.Compare(expected, got)))
 }
 
 func (tester Tester) formattedError(format string, a []interface{}, following ...interface{}) {
@@ -158,10 +407,74 @@ func (tester Tester) formattedError(format string, a []interface{}, following ..
 		var args []interface{}
 		args = append(args, fmt.Sprint(a...))
 		args = append(args, following...)
-		tester.T.Errorf("%v: "+format, args...)
+		tester.errorf("%v: "+format, args...)
 	} else {
-		tester.T.Errorf(format, following...)
+		tester.errorf(format, following...)
+	}
+}
+
+// errorf raises an error on T, stopping the test if the Tester is in Fatal mode.
+func (tester Tester) errorf(format string, args ...interface{}) {
+	tester.T.Helper()
+	tester.T.Errorf(format, args...)
+	if !tester.Fatal {
+		return
+	}
+	ft, ok := tester.T.(FatalTestingT)
+	if !ok {
+		// Continuing would allow the test to use values that the failed check was guarding
+		panic(fmt.Sprintf("must: Tester.Fatal is set, but %T does not implement FailNow, so the test cannot be stopped", tester.T))
+	}
+	ft.FailNow()
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// errorAsTargetType validates target for use with errors.As, which panics on invalid targets.
+func errorAsTargetType(target interface{}) (reflect.Type, error) {
+	if target == nil {
+		return nil, errors.New("target cannot be nil")
+	}
+	val := reflect.ValueOf(target)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return nil, fmt.Errorf("target must be a non-nil pointer, got %T", target)
+	}
+	elem := val.Type().Elem()
+	if elem.Kind() != reflect.Interface && !elem.Implements(errorType) {
+		return nil, fmt.Errorf("target must point to an interface or a type implementing error, got %T", target)
+	}
+	return elem, nil
+}
+
+// errorChain describes err and every error it wraps, one per line with the type and message of each.
+func errorChain(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	var lines []string
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		if isNil(err) {
+			// Calling methods on a typed nil error may panic
+			lines = append(lines, fmt.Sprintf("%s<nil %T>", strings.Repeat("  ", depth+1), err))
+			return
+		}
+		lines = append(lines, fmt.Sprintf("%s%T: %s", strings.Repeat("  ", depth+1), err, err.Error()))
+		switch wrapper := err.(type) {
+		case interface{ Unwrap() error }:
+			if next := wrapper.Unwrap(); next != nil {
+				walk(next, depth+1)
+			}
+		case interface{ Unwrap() []error }:
+			for _, next := range wrapper.Unwrap() {
+				if next != nil {
+					walk(next, depth+1)
+				}
+			}
+		}
 	}
+	walk(err, 0)
+	return strings.Join(lines, "\n")
 }
 
 func getErrMessage(err error) string {
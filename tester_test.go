@@ -2,7 +2,10 @@ package must
 
 import (
 	"errors"
+	"fmt"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -245,6 +248,12 @@ func TestBeNoError(t *testing.T) {
 			shouldPass: false,
 			format:     "%v: error: %s",
 		},
+		{
+			name:       "Typed nil error",
+			got:        (*typedError)(nil),
+			shouldPass: false,
+			format:     "%v: error: got a nil %T, which is not equal to nil when stored in an error",
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -291,6 +300,12 @@ func TestBeError(t *testing.T) {
 			params:     []interface{}{"param1", 2, true, 4.0},
 			format:     "%v: expected an error, but got nil",
 		},
+		{
+			name:       "input is typed nil",
+			got:        (*typedError)(nil),
+			shouldPass: false,
+			format:     "expected an error, but got a nil %T, which is not equal to nil when stored in an error",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -358,6 +373,414 @@ func TestBeErrorIf(t *testing.T) {
 	}
 }
 
+func TestFatal(t *testing.T) {
+	var tests = []struct {
+		name          string
+		fatal         bool
+		got           error
+		shouldFailNow bool
+	}{
+		{
+			name:  "fatal with no error",
+			fatal: true,
+		},
+		{
+			name:          "fatal with error",
+			fatal:         true,
+			got:           errors.New("test error"),
+			shouldFailNow: true,
+		},
+		{
+			name: "not fatal with error",
+			got:  errors.New("test error"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockFatalTesting{}
+			tester := Tester{
+				T:     m,
+				Fatal: test.fatal,
+			}
+			tester.BeNoError(test.got)
+			if m.errorCalled != (test.got != nil) {
+				t.Errorf("errorCalled not as expected: got %v", m.errorCalled)
+			}
+			if m.failNowCalled != test.shouldFailNow {
+				t.Errorf("failNowCalled not as expected: expected %v, got %v", test.shouldFailNow, m.failNowCalled)
+			}
+		})
+	}
+}
+
+func TestRequire(t *testing.T) {
+	m := &MockFatalTesting{}
+	if Require(m).BeEqual(1, 2) {
+		t.Error("Check did not fail as expected")
+	}
+	if !m.failNowCalled {
+		t.Error("FailNow was not called")
+	}
+}
+
+func TestFatalWithoutFailNow(t *testing.T) {
+	m := &MockTesting{}
+	tester := Tester{
+		T:     m,
+		Fatal: true,
+	}
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Error("Expected a panic when T cannot stop the test")
+		}
+		if !strings.Contains(fmt.Sprint(r), "*must.MockTesting does not implement FailNow") {
+			t.Errorf("Incorrect panic, got: %v", r)
+		}
+		if !m.errorCalled {
+			t.Error("Error was not raised before panicking")
+		}
+	}()
+	tester.BeError(nil)
+	t.Error("Check did not stop the test")
+}
+
+var errSentinel = errors.New("sentinel")
+
+func TestBeErrorIs(t *testing.T) {
+	var tests = []struct {
+		name       string
+		target     error
+		got        error
+		params     []interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "same error",
+			target:     errSentinel,
+			got:        errSentinel,
+			shouldPass: true,
+		},
+		{
+			name:       "wrapped error",
+			target:     errSentinel,
+			got:        fmt.Errorf("context: %w", errSentinel),
+			shouldPass: true,
+		},
+		{
+			name:   "different error",
+			target: errSentinel,
+			got:    errors.New("sentinel"),
+			format: "expected error chain to contain '%v', got:\n%s",
+		},
+		{
+			name:   "nil with params",
+			target: errSentinel,
+			params: []interface{}{"param1"},
+			format: "%v: expected error chain to contain '%v', got:\n%s",
+		},
+		{
+			name:   "typed nil",
+			target: errSentinel,
+			got:    (*messageError)(nil),
+			format: "expected error chain to contain '%v', got:\n%s",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeErrorIs(test.target, test.got, test.params...)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestBeErrorAs(t *testing.T) {
+	var pathErr *os.PathError
+	var tests = []struct {
+		name       string
+		got        error
+		target     interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "wrapped matching type",
+			got:        fmt.Errorf("context: %w", &os.PathError{Op: "open", Path: "file", Err: errSentinel}),
+			target:     &pathErr,
+			shouldPass: true,
+		},
+		{
+			name:   "no matching type",
+			got:    fmt.Errorf("context: %w", errSentinel),
+			target: &pathErr,
+			format: "expected error chain to contain %v, got:\n%s",
+		},
+		{
+			name:   "nil target",
+			got:    errSentinel,
+			format: "invalid target - %v",
+		},
+		{
+			name:   "non-pointer target",
+			got:    errSentinel,
+			target: pathErr,
+			format: "invalid target - %v",
+		},
+		{
+			name:   "typed nil",
+			got:    (*messageError)(nil),
+			target: &pathErr,
+			format: "expected error chain to contain %v, got:\n%s",
+		},
+		{
+			name:   "pointer to non-error target",
+			got:    errSentinel,
+			target: new(string),
+			format: "invalid target - %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeErrorAs(test.got, test.target)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestErrorChain(t *testing.T) {
+	err := fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", errSentinel))
+	expected := "  *fmt.wrapError: outer: inner: sentinel\n" +
+		"    *fmt.wrapError: inner: sentinel\n" +
+		"      *errors.errorString: sentinel"
+	if got := errorChain(err); got != expected {
+		t.Errorf("Incorrect chain. Expected:\n%v\ngot:\n%v", expected, got)
+	}
+	if got := errorChain(nil); got != "<nil>" {
+		t.Errorf("Incorrect chain for nil, got: %v", got)
+	}
+
+	err = fmt.Errorf("outer: %w", (*messageError)(nil))
+	expected = "  *fmt.wrapError: outer: <nil>\n" +
+		"    <nil *must.messageError>"
+	if got := errorChain(err); got != expected {
+		t.Errorf("Incorrect chain for typed nil. Expected:\n%v\ngot:\n%v", expected, got)
+	}
+}
+
+func TestBeErrorContaining(t *testing.T) {
+	var tests = []struct {
+		name       string
+		substr     string
+		got        error
+		params     []interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "message contains substring",
+			substr:     "config.yaml",
+			got:        errors.New("open config.yaml: permission denied"),
+			shouldPass: true,
+		},
+		{
+			name:   "message does not contain substring",
+			substr: "config.json",
+			got:    errors.New("open config.yaml: permission denied"),
+			format: "expected error message to contain %q, got:\n%s",
+		},
+		{
+			name:   "nil error with params",
+			substr: "config.yaml",
+			params: []interface{}{"param1"},
+			format: "%v: expected an error containing %q, but got nil",
+		},
+		{
+			name:   "typed nil error",
+			substr: "config.yaml",
+			got:    (*messageError)(nil),
+			format: "expected an error containing %q, but got a nil %T, which is not equal to nil when stored in an error",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeErrorContaining(test.substr, test.got, test.params...)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestBeErrorMatching(t *testing.T) {
+	var tests = []struct {
+		name       string
+		pattern    string
+		got        error
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "message matches",
+			pattern:    `open \w+\.yaml`,
+			got:        errors.New("open config.yaml: permission denied"),
+			shouldPass: true,
+		},
+		{
+			name:    "message does not match",
+			pattern: `open \w+\.json`,
+			got:     errors.New("open config.yaml: permission denied"),
+			format:  "expected error message to match %q, got:\n%s",
+		},
+		{
+			name:    "invalid pattern",
+			pattern: `open (`,
+			got:     errors.New("open config.yaml: permission denied"),
+			format:  "invalid pattern - %v",
+		},
+		{
+			name:    "nil error",
+			pattern: `open`,
+			format:  "expected an error matching %q, but got nil",
+		},
+		{
+			name:    "typed nil error",
+			pattern: `open`,
+			got:     (*messageError)(nil),
+			format:  "expected an error matching %q, but got a nil %T, which is not equal to nil when stored in an error",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeErrorMatching(test.pattern, test.got)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+type typedError struct{}
+
+func (e *typedError) Error() string {
+	return "typed error"
+}
+
+// messageError dereferences its receiver, so calling Error on a nil *messageError panics.
+type messageError struct {
+	message string
+}
+
+func (e *messageError) Error() string {
+	return e.message
+}
+
+func TestBeNil(t *testing.T) {
+	var nilMap map[string]int
+	var tests = []struct {
+		name       string
+		got        interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "nil",
+			shouldPass: true,
+		},
+		{
+			name:       "nil pointer",
+			got:        (*typedError)(nil),
+			shouldPass: true,
+		},
+		{
+			name:       "nil map",
+			got:        nilMap,
+			shouldPass: true,
+		},
+		{
+			name:       "nil func",
+			got:        (func())(nil),
+			shouldPass: true,
+		},
+		{
+			name:   "non-nil pointer",
+			got:    &typedError{},
+			format: "expected nil, got %s",
+		},
+		{
+			name:   "zero int",
+			got:    0,
+			format: "expected nil, got %s",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeNil(test.got)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestBeNotNil(t *testing.T) {
+	var tests = []struct {
+		name       string
+		got        interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:   "nil",
+			format: "expected a non-nil value, got nil",
+		},
+		{
+			name:   "nil slice",
+			got:    []int(nil),
+			format: "expected a non-nil value, got a nil %T",
+		},
+		{
+			name:   "nil error in interface",
+			got:    error((*typedError)(nil)),
+			format: "expected a non-nil value, got a nil %T",
+		},
+		{
+			name:       "empty slice",
+			got:        []int{},
+			shouldPass: true,
+		},
+		{
+			name:       "struct",
+			got:        typedError{},
+			shouldPass: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeNotNil(test.got)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
 func checkResults(
 	t *testing.T,
 	expectedResult,
@@ -447,6 +870,26 @@ func TestBeSameLength(t *testing.T) {
 			shouldPass: false,
 			format:     "%v: could not test lengths - %v",
 		},
+		{
+			name:       "Nil and empty slice",
+			expected:   nil,
+			got:        []int{},
+			shouldPass: true,
+		},
+		{
+			name:       "Nil string pointer and string",
+			expected:   (*string)(nil),
+			got:        "abc",
+			shouldPass: false,
+			format:     "%v: expected length %d, got length %d",
+		},
+		{
+			name:       "Nil struct pointer and string",
+			expected:   (*struct{})(nil),
+			got:        "abc",
+			shouldPass: false,
+			format:     "%v: could not test lengths - %v",
+		},
 		{
 			name:       "Struct and string",
 			got:        "abc",
@@ -486,6 +929,137 @@ func TestBeSameLength(t *testing.T) {
 	}
 }
 
+func TestBeLength(t *testing.T) {
+	var tests = []struct {
+		name       string
+		expected   int
+		got        interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "slice with expected length",
+			expected:   3,
+			got:        []int{1, 2, 3},
+			shouldPass: true,
+		},
+		{
+			name:     "slice with different length",
+			expected: 2,
+			got:      []int{1, 2, 3},
+			format:   "expected length %d, got length %d: %s",
+		},
+		{
+			name:       "nil",
+			expected:   0,
+			got:        nil,
+			shouldPass: true,
+		},
+		{
+			name:       "nil map pointer",
+			expected:   0,
+			got:        (*map[string]int)(nil),
+			shouldPass: true,
+		},
+		{
+			name:     "int",
+			expected: 1,
+			got:      1,
+			format:   "could not test length - %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeLength(test.expected, test.got)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestBeEmpty(t *testing.T) {
+	var tests = []struct {
+		name       string
+		got        interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "empty string",
+			got:        "",
+			shouldPass: true,
+		},
+		{
+			name:       "nil slice",
+			got:        []int(nil),
+			shouldPass: true,
+		},
+		{
+			name:       "nil interface",
+			got:        nil,
+			shouldPass: true,
+		},
+		{
+			name:   "non-empty map",
+			got:    map[string]int{"a": 1},
+			format: "expected empty, got length %d: %s",
+		},
+		{
+			name:   "struct",
+			got:    struct{}{},
+			format: "could not test length - %v",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeEmpty(test.got)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestBeNotEmpty(t *testing.T) {
+	var tests = []struct {
+		name       string
+		got        interface{}
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "non-empty slice",
+			got:        []int{1},
+			shouldPass: true,
+		},
+		{
+			name:   "empty string pointer",
+			got:    stringToPointer(""),
+			format: "expected not empty, got %s",
+		},
+		{
+			name:   "nil",
+			got:    nil,
+			format: "expected not empty, got %s",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := tester.BeNotEmpty(test.got)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
 func stringToPointer(val string) *string {
 	return &val
 }
@@ -505,3 +1079,12 @@ func (m *MockTesting) Errorf(format string, args ...interface{}) {
 func (m *MockTesting) Helper() {
 	// Nothing to do
 }
+
+type MockFatalTesting struct {
+	MockTesting
+	failNowCalled bool
+}
+
+func (m *MockFatalTesting) FailNow() {
+	m.failNowCalled = true
+}
@@ -0,0 +1,135 @@
+package must
+
+import (
+	"strings"
+)
+
+/*
+TextOptions configures how strings are normalized before they are compared and diffed.
+
+The zero value compares strings exactly.
+*/
+type TextOptions struct {
+	NormalizeLineEndings bool // Convert CRLF and CR line endings to LF
+	TrimTrailingSpace    bool // Remove spaces and tabs from the end of each line
+	CollapseIndentation  bool // Replace the leading spaces and tabs on each line with a single space, ignoring differences in the width of indentation
+	Dedent               bool // Remove indentation common to all lines, along with a blank first and last line, as found in indented raw string literals
+}
+
+// defaultTextOptions are used by BeEqualText when a Tester has no TextOptions set.
+var defaultTextOptions = TextOptions{
+	NormalizeLineEndings: true,
+	TrimTrailingSpace:    true,
+}
+
+/*
+BeEqualText compares the expected and got strings after normalizing their whitespace, triggering an error on t if they are not equal.
+Line endings are normalized to LF, and trailing spaces and tabs are removed from each line.
+
+Should the strings differ only in whitespace, the diff will show invisible characters, with ␍ for carriage returns, ␊ for line feeds, · for spaces and → for tabs.
+
+The return value will be true if the normalized strings are equal.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeEqualText(t TestingT, expected, got string, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeEqualText(expected, got, a...)
+}
+
+/*
+BeEqualText compares the expected and got strings after normalizing them according to the Tester's Options.Text, triggering an error on the Tester's T if they are not equal.
+Should no TextOptions be set, line endings and trailing whitespace will be normalized.
+
+This corresponds to the function BeEqualText
+*/
+func (tester Tester) BeEqualText(expected, got string, a ...interface{}) bool {
+	tester.T.Helper()
+	if tester.Options.Text == (TextOptions{}) {
+		tester.Options.Text = defaultTextOptions
+	}
+	if tester.Options.Text.normalize(expected) == tester.Options.Text.normalize(got) {
+		return true
+	}
+	tester.formattedError("diff\n%s", a, tester.diff(expected, got))
+	return false
+}
+
+// normalize applies the options to s.
+func (options TextOptions) normalize(s string) string {
+	if options.NormalizeLineEndings {
+		s = strings.Replace(s, "\r\n", "\n", -1)
+		s = strings.Replace(s, "\r", "\n", -1)
+	}
+	if options.Dedent {
+		s = dedent(s)
+	}
+	if !options.TrimTrailingSpace && !options.CollapseIndentation {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if options.TrimTrailingSpace {
+			line = strings.TrimRight(line, " \t")
+		}
+		if options.CollapseIndentation {
+			if trimmed := strings.TrimLeft(line, " \t"); len(trimmed) < len(line) {
+				line = " " + trimmed
+			}
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// dedent removes a blank first and last line from s, along with the leading whitespace common to all non-blank lines.
+func dedent(s string) string {
+	lines := strings.Split(s, "\n")
+	if len(lines) > 1 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	if len(lines) > 1 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	var common string
+	first := true
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if first {
+			common, first = indent, false
+			continue
+		}
+		common = common[:commonPrefixLength(common, indent)]
+	}
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+			continue
+		}
+		lines[i] = strings.TrimPrefix(line, common)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// onlyWhitespaceDiffers reports whether a and b are different, but contain the same text aside from whitespace.
+func onlyWhitespaceDiffers(a, b string) bool {
+	return a != b && strings.Join(strings.Fields(a), " ") == strings.Join(strings.Fields(b), " ")
+}
+
+var visibleWhitespaceReplacer = strings.NewReplacer(
+	"\r", "␍",
+	"\n", "␊\n",
+	" ", "·",
+	"\t", "→",
+)
+
+// visibleWhitespace replaces whitespace characters in s with visible symbols, retaining line breaks.
+func visibleWhitespace(s string) string {
+	return visibleWhitespaceReplacer.Replace(s)
+}
@@ -0,0 +1,158 @@
+package must
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTextOptionsNormalize(t *testing.T) {
+	var tests = []struct {
+		name     string
+		options  TextOptions
+		in       string
+		expected string
+	}{
+		{
+			name:     "zero options",
+			in:       "a \r\nb",
+			expected: "a \r\nb",
+		},
+		{
+			name:     "line endings",
+			options:  TextOptions{NormalizeLineEndings: true},
+			in:       "a\r\nb\rc\n",
+			expected: "a\nb\nc\n",
+		},
+		{
+			name:     "trailing space",
+			options:  TextOptions{TrimTrailingSpace: true},
+			in:       "a \t\nb  \n c",
+			expected: "a\nb\n c",
+		},
+		{
+			name:     "collapse indentation",
+			options:  TextOptions{CollapseIndentation: true},
+			in:       "a\n\tb\n    c",
+			expected: "a\n b\n c",
+		},
+		{
+			name:     "dedent",
+			options:  TextOptions{Dedent: true},
+			in:       "\n\t\tfunc main() {\n\t\t\treturn\n\n\t\t}\n\t",
+			expected: "func main() {\n\treturn\n\n}",
+		},
+		{
+			name:     "dedent mixed indentation",
+			options:  TextOptions{Dedent: true},
+			in:       "  \ta\n  b",
+			expected: "\ta\nb",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.options.normalize(test.in); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestBeEqualText(t *testing.T) {
+	var tests = []struct {
+		name       string
+		options    TextOptions
+		expected   string
+		got        string
+		shouldPass bool
+		contains   []string
+	}{
+		{
+			name:       "line endings and trailing space",
+			expected:   "a\nb\n",
+			got:        "a  \r\nb\t\r\n",
+			shouldPass: true,
+		},
+		{
+			name:     "different text",
+			expected: "a\nb",
+			got:      "a\nc",
+			contains: []string{"-b", "+c"},
+		},
+		{
+			name:       "dedent",
+			options:    TextOptions{Dedent: true},
+			expected:   "\n\t\tline 1\n\t\t\tline 2\n\t",
+			got:        "line 1\n\tline 2",
+			shouldPass: true,
+		},
+		{
+			name:     "options replace defaults",
+			options:  TextOptions{Dedent: true},
+			expected: "a\r\nb",
+			got:      "a\nb",
+			contains: []string{"a␍␊"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T:       m,
+				Options: CompareOptions{Text: test.options},
+			}
+			result := tester.BeEqualText(test.expected, test.got)
+			var format string
+			if !test.shouldPass {
+				format = "diff\n%s"
+			}
+			checkResults(t, test.shouldPass, result, format, m)
+			if len(test.contains) == 0 {
+				return
+			}
+			if len(m.args) != 1 {
+				t.Fatalf("expected one argument, got %v", m.args)
+			}
+			for _, s := range test.contains {
+				if !strings.Contains(m.args[0].(string), s) {
+					t.Errorf("expected diff to contain %q, got:\n%v", s, m.args[0])
+				}
+			}
+		})
+	}
+}
+
+func TestWhitespaceDiffIsVisible(t *testing.T) {
+	m := &MockTesting{}
+	tester := Tester{T: m}
+	tester.BeEqual("key: value\n\tnext", "key:  value \n    next")
+
+	rendered := m.args[0].(string)
+	for _, s := range []string{"-key:·value␊", "+key:··value·␊", "-→next", "+····next"} {
+		if !strings.Contains(rendered, s) {
+			t.Errorf("expected diff to contain %q, got:\n%s", s, rendered)
+		}
+	}
+
+	m = &MockTesting{}
+	tester = Tester{T: m}
+	tester.BeEqual("key: value", "key: other")
+	if rendered := m.args[0].(string); strings.Contains(rendered, "·") {
+		t.Errorf("expected whitespace to be rendered as-is when text differs, got:\n%s", rendered)
+	}
+}
+
+func TestBeEqualWithTextOptions(t *testing.T) {
+	m := &MockTesting{}
+	tester := Tester{
+		T: m,
+		Options: CompareOptions{
+			Text: TextOptions{NormalizeLineEndings: true},
+		},
+	}
+	type file struct {
+		Name    string
+		Content string
+	}
+	result := tester.BeEqual(file{Name: "a", Content: "x\ny\n"}, file{Name: "a", Content: "x\r\ny\r\n"})
+	checkResults(t, true, result, "", m)
+}
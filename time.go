@@ -0,0 +1,117 @@
+package must
+
+import "time"
+
+/*
+BeSameTime checks that expected and got represent the same instant, triggering an error on t if they do not.
+Unlike BeEqual, the location and monotonic clock reading of each time are ignored.
+
+The return value will be true if the times represent the same instant.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeSameTime(t TestingT, expected, got time.Time, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeSameTime(expected, got, a...)
+}
+
+/*
+BeWithinDuration checks that got is no more than delta before or after expected, triggering an error on t if it is not.
+
+The return value will be true if the difference between the times is at most delta.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeWithinDuration(t TestingT, expected, got time.Time, delta time.Duration, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeWithinDuration(expected, got, delta, a...)
+}
+
+/*
+BeBefore checks that got is before bound, triggering an error on t if it is not.
+
+The return value will be true if got is before bound.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeBefore(t TestingT, bound, got time.Time, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeBefore(bound, got, a...)
+}
+
+/*
+BeAfter checks that got is after bound, triggering an error on t if it is not.
+
+The return value will be true if got is after bound.
+
+Additional output for any error message can be provided as additional parameters, as with fmt.Print.
+*/
+func BeAfter(t TestingT, bound, got time.Time, a ...interface{}) bool {
+	t.Helper()
+	mt := Tester{T: t}
+	return mt.BeAfter(bound, got, a...)
+}
+
+/*
+BeSameTime checks that expected and got represent the same instant, triggering an error on the Tester's T if they do not.
+
+This corresponds to the function BeSameTime
+*/
+func (tester Tester) BeSameTime(expected, got time.Time, a ...interface{}) bool {
+	tester.T.Helper()
+	if expected.Equal(got) {
+		return true
+	}
+	tester.formattedError("expected %s, got %s, a difference of %v", a, formatTime(expected), formatTime(got), got.Sub(expected))
+	return false
+}
+
+/*
+BeWithinDuration checks that got is no more than delta before or after expected, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeWithinDuration
+*/
+func (tester Tester) BeWithinDuration(expected, got time.Time, delta time.Duration, a ...interface{}) bool {
+	tester.T.Helper()
+	difference := got.Sub(expected)
+	if difference >= -delta && difference <= delta {
+		return true
+	}
+	tester.formattedError("expected %s to be within %v of %s, a difference of %v", a, formatTime(got), delta, formatTime(expected), difference)
+	return false
+}
+
+/*
+BeBefore checks that got is before bound, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeBefore
+*/
+func (tester Tester) BeBefore(bound, got time.Time, a ...interface{}) bool {
+	tester.T.Helper()
+	if got.Before(bound) {
+		return true
+	}
+	tester.formattedError("expected %s to be before %s", a, formatTime(got), formatTime(bound))
+	return false
+}
+
+/*
+BeAfter checks that got is after bound, triggering an error on the Tester's T if it is not.
+
+This corresponds to the function BeAfter
+*/
+func (tester Tester) BeAfter(bound, got time.Time, a ...interface{}) bool {
+	tester.T.Helper()
+	if got.After(bound) {
+		return true
+	}
+	tester.formattedError("expected %s to be after %s", a, formatTime(got), formatTime(bound))
+	return false
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
@@ -0,0 +1,151 @@
+package must
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	referenceTime = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	newYork       = time.FixedZone("EST", -5*60*60)
+)
+
+func TestTimeChecks(t *testing.T) {
+	var tests = []struct {
+		name       string
+		check      func(MustTester) bool
+		shouldPass bool
+		format     string
+	}{
+		{
+			name:       "same instant in different locations",
+			check:      func(mt MustTester) bool { return mt.BeSameTime(referenceTime, referenceTime.In(newYork)) },
+			shouldPass: true,
+		},
+		{
+			name:       "same instant with monotonic reading",
+			check:      func(mt MustTester) bool { now := time.Now(); return mt.BeSameTime(now.Round(0), now) },
+			shouldPass: true,
+		},
+		{
+			name:   "different instants",
+			check:  func(mt MustTester) bool { return mt.BeSameTime(referenceTime, referenceTime.Add(time.Second)) },
+			format: "expected %s, got %s, a difference of %v",
+		},
+		{
+			name: "within duration",
+			check: func(mt MustTester) bool {
+				return mt.BeWithinDuration(referenceTime, referenceTime.Add(-time.Second), time.Second)
+			},
+			shouldPass: true,
+		},
+		{
+			name: "outside duration",
+			check: func(mt MustTester) bool {
+				return mt.BeWithinDuration(referenceTime, referenceTime.Add(2*time.Second), time.Second)
+			},
+			format: "expected %s to be within %v of %s, a difference of %v",
+		},
+		{
+			name:       "before",
+			check:      func(mt MustTester) bool { return mt.BeBefore(referenceTime, referenceTime.Add(-time.Nanosecond)) },
+			shouldPass: true,
+		},
+		{
+			name:   "not before",
+			check:  func(mt MustTester) bool { return mt.BeBefore(referenceTime, referenceTime) },
+			format: "expected %s to be before %s",
+		},
+		{
+			name:       "after",
+			check:      func(mt MustTester) bool { return mt.BeAfter(referenceTime, referenceTime.Add(time.Hour)) },
+			shouldPass: true,
+		},
+		{
+			name:   "not after",
+			check:  func(mt MustTester) bool { return mt.BeAfter(referenceTime, referenceTime.Add(-time.Hour)) },
+			format: "expected %s to be after %s",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &MockTesting{}
+			tester := Tester{
+				T: m,
+			}
+			result := test.check(tester)
+			checkResults(t, test.shouldPass, result, test.format, m)
+		})
+	}
+}
+
+func TestBeEqualTimeByInstant(t *testing.T) {
+	type event struct {
+		Name string
+		At   time.Time
+	}
+	expected := event{Name: "start", At: referenceTime}
+	got := event{Name: "start", At: referenceTime.In(newYork)}
+
+	m := &MockTesting{}
+	if (Tester{T: m}).BeEqual(expected, got) {
+		t.Error("Expected times in different locations to differ by default")
+	}
+
+	tester := Tester{
+		T:       m,
+		Options: CompareOptions{TimeByInstant: true},
+	}
+	if !tester.BeEqual(expected, got) {
+		t.Error("Check did not pass as expected")
+	}
+
+	got.At = got.At.Add(time.Minute)
+	tester.DiffStyle = DiffPaths
+	if tester.BeEqual(expected, got) {
+		t.Error("Check did not fail as expected")
+	}
+	want := ".At: expected 2020-01-02T03:04:05Z, got 2020-01-01T22:05:05-05:00"
+	if len(m.args) != 1 || m.args[0] != want {
+		t.Errorf("Incorrect diff. Expected %q, got %v", want, m.args)
+	}
+}
+
+func TestBeEqualTimeByInstantUnexported(t *testing.T) {
+	type schedule struct {
+		events map[string]time.Time
+		next   interface{}
+		at     time.Time
+	}
+	now := time.Now()
+	var tests = []struct {
+		name     string
+		expected schedule
+		got      schedule
+		equal    bool
+	}{
+		{
+			name:     "identical",
+			expected: schedule{events: map[string]time.Time{"a": now}, next: now, at: now},
+			got:      schedule{events: map[string]time.Time{"a": now}, next: now, at: now},
+			equal:    true,
+		},
+		{
+			name:     "different instants",
+			expected: schedule{at: referenceTime},
+			got:      schedule{at: referenceTime.Add(time.Minute)},
+			equal:    false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := (Tester{}).equal(test.expected, test.got); got != test.equal {
+				t.Errorf("default comparison: expected %v, got %v", test.equal, got)
+			}
+			tester := Tester{Options: CompareOptions{TimeByInstant: true}}
+			if got := tester.equal(test.expected, test.got); got != test.equal {
+				t.Errorf("comparison by instant: expected %v, got %v", test.equal, got)
+			}
+		})
+	}
+}